@@ -0,0 +1,290 @@
+package twofive
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// DefaultIncrement is the amount a second price auction adds to the price it clears at when the Auction
+// doesn't set one
+const DefaultIncrement = 0.01
+
+// ErrNoConverter is returned when prices in more than one currency need comparing and the Auction has no
+// CurrencyConverter to do so
+var ErrNoConverter = errors.New("twofive: no currency converter")
+
+// Auctioneer picks the winning bid of each impression of a request from the responses of the bidders
+type Auctioneer interface {
+	Run(req *Request, responses ...*BidResponse) (*AuctionResult, error)
+}
+
+// CurrencyConverter converts an amount from one currency to another, both given as ISO-4217 codes
+type CurrencyConverter interface {
+	Convert(amount float64, from, to string) (float64, error)
+}
+
+// CurrencyConverterFunc adapts an ordinary function to a CurrencyConverter
+type CurrencyConverterFunc func(amount float64, from, to string) (float64, error)
+
+// Convert calls f(amount, from, to)
+func (f CurrencyConverterFunc) Convert(amount float64, from, to string) (float64, error) {
+	return f(amount, from, to)
+}
+
+// Auction is the Auctioneer of the spec. Each impression is won by its highest eligible bid, which pays its own
+// price in a first price auction, or the larger of the runner up and the floor plus Increment in a second
+// price auction, never more than it bid. The auction type is the request's unless the deal bid on sets its
+// own, and a fixed price deal (at 3) pays the deal's floor. Bids are first checked with ValidateResponse,
+// private auctions only admit bids on their deals, and a seat bidding as a group either wins every impression
+// it bid on or none of them
+type Auction struct {
+	Increment float64           // DefaultIncrement if zero
+	Converter CurrencyConverter // required when bids or floors aren't in the currency of the auction
+}
+
+// AuctionResult is the outcome of every bid of an auction. Prices are in Currency, the first currency of the
+// request or the DefaultCurrency
+type AuctionResult struct {
+	Currency string
+	Winners  []BidOutcome // at most one per impression, in the order of the impressions
+	Losers   []BidOutcome // in the order of the responses and their bids
+}
+
+// Winner returns the winning bid of the impression, if it had one
+func (a *AuctionResult) Winner(impID string) (BidOutcome, bool) {
+	for _, w := range a.Winners {
+		if w.ImpID == impID {
+			return w, true
+		}
+	}
+	return BidOutcome{}, false
+}
+
+// BidOutcome is how a single bid fared. Price is what a winner pays, and the bid price of a loser, in the
+// currency of the auction. Reason is LossBidWon for winners
+type BidOutcome struct {
+	ImpID    string
+	Seat     string
+	Bid      *Bid
+	Response *BidResponse
+	Deal     *Deal // the deal bid on, if any
+	Price    float64
+	Reason   LossReason
+}
+
+// candidate is a bid still in contention, price and floor are in the currency of the auction
+type candidate struct {
+	BidOutcome
+	imp     *Imp
+	seatbid *Seatbid
+	floor   float64
+	at      int // the auction type of the request
+	order   int
+}
+
+// Run runs the auction, see Auction. An error is only returned when the request can't be auctioned, invalid
+// bids lose with the reason they were rejected for
+func (a Auction) Run(req *Request, responses ...*BidResponse) (*AuctionResult, error) {
+	res := &AuctionResult{Currency: DefaultCurrency}
+	if len(req.Cur) > 0 {
+		res.Currency = req.Cur[0]
+	}
+	at := req.At
+	if at == 0 {
+		at = 2
+	}
+
+	imps := make(map[string]*Imp, len(req.Imp))
+	floors := make(map[string]float64, len(req.Imp))
+	for i := range req.Imp {
+		imp := &req.Imp[i]
+		floor, err := a.convert(imp.BidFloor, imp.BidFloorCur, res.Currency)
+		if err != nil {
+			return nil, err
+		}
+		imps[imp.ID], floors[imp.ID] = imp, floor
+	}
+
+	var candidates []*candidate
+	for _, resp := range responses {
+		rejected := make(map[bidKey]LossReason)
+		if rejections, ok := ValidateResponse(req, resp).(BidRejections); ok {
+			for _, r := range rejections {
+				rejected[bidKey{r.Seat, r.BidID, r.ImpID}] = r.Reason
+			}
+		}
+
+		for i := range resp.SeatBid {
+			sb := &resp.SeatBid[i]
+			for j := range sb.Bid {
+				bid := &sb.Bid[j]
+				c := &candidate{
+					BidOutcome: BidOutcome{ImpID: bid.ImpID, Seat: sb.Seat, Bid: bid, Response: resp},
+					imp:        imps[bid.ImpID],
+					seatbid:    sb,
+					at:         at,
+					order:      len(candidates),
+				}
+				candidates = append(candidates, c)
+
+				if reason, ok := rejected[bidKey{sb.Seat, bid.ID, bid.ImpID}]; ok {
+					c.Price, c.Reason = bid.Price, reason
+					continue
+				}
+				a.admit(c, resp.Cur, res.Currency, floors[bid.ImpID])
+			}
+		}
+	}
+
+	winners := a.award(candidates)
+	for _, imp := range req.Imp {
+		if w, ok := winners[imp.ID]; ok {
+			res.Winners = append(res.Winners, w.BidOutcome)
+		}
+	}
+	for _, c := range candidates {
+		if c.Reason != LossBidWon {
+			res.Losers = append(res.Losers, c.BidOutcome)
+		}
+	}
+	return res, nil
+}
+
+type bidKey struct {
+	seat, bid, imp string
+}
+
+// admit converts the price of a valid bid into the currency of the auction, and sets a loss reason when the
+// bid isn't eligible to win
+func (a Auction) admit(c *candidate, from, to string, floor float64) {
+	price, err := a.convert(c.Bid.Price, from, to)
+	if err != nil {
+		c.Price, c.Reason = c.Bid.Price, LossInvalidBidResponse
+		return
+	}
+	c.Price, c.floor = price, floor
+
+	if c.Bid.DealID != "" && c.imp.PMP != nil {
+		for i := range c.imp.PMP.Deals {
+			if c.imp.PMP.Deals[i].ID == c.Bid.DealID {
+				c.Deal = &c.imp.PMP.Deals[i]
+				break
+			}
+		}
+	}
+
+	switch {
+	case c.Deal == nil && c.imp.PMP != nil && c.imp.PMP.PrivateAuction == 1:
+		c.Reason = LossInvalidDealID
+	case c.Deal != nil:
+		// deals share the currency of the impression floor
+		if c.floor, err = a.convert(c.Deal.BidFloor, c.imp.BidFloorCur, to); err != nil {
+			c.Reason = LossInvalidBidResponse
+		} else if c.Price < c.floor {
+			c.Reason = LossBelowDealFloor
+		}
+	case c.Price < c.floor:
+		c.Reason = LossBelowAuctionFloor
+	}
+	if c.Reason == LossBidWon && c.Price <= 0 {
+		c.Reason = LossMissingBidPrice
+	}
+}
+
+// award picks the winner of each impression from the eligible candidates, setting the loss reason of the
+// rest. Groups that don't win all their bids are withdrawn and the impressions awarded again until every
+// group is either won in full or out of contention
+func (a Auction) award(candidates []*candidate) map[string]*candidate {
+	for {
+		byImp := make(map[string][]*candidate)
+		for _, c := range candidates {
+			if c.Reason == LossBidWon {
+				byImp[c.ImpID] = append(byImp[c.ImpID], c)
+			}
+		}
+
+		winners := make(map[string]*candidate, len(byImp))
+		for id, cs := range byImp {
+			sort.SliceStable(cs, func(i, j int) bool {
+				if cs[i].Price != cs[j].Price {
+					return cs[i].Price > cs[j].Price
+				}
+				return cs[i].order < cs[j].order
+			})
+			winners[id] = cs[0]
+		}
+
+		withdrawn := false
+		for _, c := range candidates {
+			if c.Reason == LossBidWon && c.seatbid.Group == 1 && !groupWon(c.seatbid, winners) {
+				c.Reason, withdrawn = LossLostToHigherBid, true
+			}
+		}
+		if withdrawn {
+			continue
+		}
+
+		for _, cs := range byImp {
+			w := cs[0]
+			w.Price = a.clear(cs)
+			for _, l := range cs[1:] {
+				l.Reason = LossLostToHigherBid
+				if w.Deal != nil && l.Deal == nil {
+					l.Reason = LossLostToPMPDeal
+				}
+			}
+		}
+		return winners
+	}
+}
+
+// groupWon reports if every bid of the seat bid is the winner of its impression
+func groupWon(sb *Seatbid, winners map[string]*candidate) bool {
+	for i := range sb.Bid {
+		if w := winners[sb.Bid[i].ImpID]; w == nil || w.Bid != &sb.Bid[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// clear returns the price the first of the ranked candidates pays
+func (a Auction) clear(ranked []*candidate) float64 {
+	w := ranked[0]
+	at := w.at
+	if w.Deal != nil && w.Deal.At != 0 {
+		at = w.Deal.At
+	}
+
+	switch at {
+	case 1:
+		return w.Price
+	case 3:
+		return w.floor
+	}
+
+	increment := a.Increment
+	if increment == 0 {
+		increment = DefaultIncrement
+	}
+	price := w.floor
+	if len(ranked) > 1 && ranked[1].Price > price {
+		price = ranked[1].Price
+	}
+	// round away the float error of adding the increment to a price in cents
+	return math.Min(math.Round((price+increment)*1e6)/1e6, w.Price)
+}
+
+func (a Auction) convert(amount float64, from, to string) (float64, error) {
+	if from == "" {
+		from = DefaultCurrency
+	}
+	if amount == 0 || from == to {
+		return amount, nil
+	}
+	if a.Converter == nil {
+		return 0, ErrNoConverter
+	}
+	return a.Converter.Convert(amount, from, to)
+}
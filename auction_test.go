@@ -0,0 +1,171 @@
+package twofive
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuction(t *testing.T) {
+	const secondImpID = "second"
+	seat := func(name string, group int, bids ...Bid) Seatbid { return Seatbid{Seat: name, Group: group, Bid: bids} }
+	bid := func(id string, price float64) Bid { return Bid{ID: id, ImpID: staticImpID, Price: price} }
+	response := func(cur string, seats ...Seatbid) *BidResponse {
+		return &BidResponse{ID: staticImpID, Cur: cur, SeatBid: seats}
+	}
+	double := CurrencyConverterFunc(func(amount float64, from, to string) (float64, error) { return amount * 2, nil })
+
+	type won struct {
+		bid   string
+		price float64
+	}
+	type lost struct {
+		bid    string
+		reason LossReason
+	}
+	tests := []struct {
+		name      string
+		auction   Auction
+		mutate    func(r *Request)
+		responses []*BidResponse
+		winners   []won
+		losers    []lost
+	}{
+		{
+			name:      "First Price",
+			mutate:    func(r *Request) { r.At = 1 },
+			responses: []*BidResponse{response("", seat("a", 0, bid("a1", 3))), response("", seat("b", 0, bid("b1", 2.5)))},
+			winners:   []won{{"a1", 3}},
+			losers:    []lost{{"b1", LossLostToHigherBid}},
+		},
+		{
+			name:      "Second Price",
+			mutate:    func(r *Request) { r.At = 2 },
+			responses: []*BidResponse{response("", seat("a", 0, bid("a1", 3))), response("", seat("b", 0, bid("b1", 2.5)))},
+			winners:   []won{{"a1", 2.51}},
+			losers:    []lost{{"b1", LossLostToHigherBid}},
+		},
+		{
+			name:      "Second Price Increment",
+			auction:   Auction{Increment: 0.25},
+			mutate:    func(r *Request) { r.At = 0 },
+			responses: []*BidResponse{response("", seat("a", 0, bid("a1", 3), bid("a2", 2.9)))},
+			winners:   []won{{"a1", 3}},
+			losers:    []lost{{"a2", LossLostToHigherBid}},
+		},
+		{
+			name:      "Second Price Floor",
+			mutate:    func(r *Request) { r.At = 2 },
+			responses: []*BidResponse{response("", seat("a", 0, bid("a1", 3), bid("a2", 1.5)))},
+			winners:   []won{{"a1", 2.01}},
+			losers:    []lost{{"a2", LossBelowAuctionFloor}},
+		},
+		{
+			name: "Fixed Price Deal",
+			mutate: func(r *Request) {
+				r.Imp[0].PMP = &PMP{Deals: []Deal{{ID: "deal1", BidFloor: 4, At: 3}}}
+			},
+			responses: []*BidResponse{
+				response("", seat("a", 0, Bid{ID: "a1", ImpID: staticImpID, Price: 5, DealID: "deal1"})),
+				response("", seat("b", 0, bid("b1", 4.5))),
+			},
+			winners: []won{{"a1", 4}},
+			losers:  []lost{{"b1", LossLostToPMPDeal}},
+		},
+		{
+			name: "Private Auction",
+			mutate: func(r *Request) {
+				r.Imp[0].PMP = &PMP{PrivateAuction: 1, Deals: []Deal{{ID: "deal1", BidFloor: 3, At: 2}}}
+			},
+			responses: []*BidResponse{
+				response("", seat("a", 0, Bid{ID: "a1", ImpID: staticImpID, Price: 5, DealID: "deal1"})),
+				response("", seat("b", 0, bid("b1", 10))),
+			},
+			winners: []won{{"a1", 3.01}},
+			losers:  []lost{{"b1", LossInvalidDealID}},
+		},
+		{
+			name:      "Converted Currency",
+			auction:   Auction{Converter: double},
+			mutate:    func(r *Request) { r.Cur = []string{"USD", "EUR"} },
+			responses: []*BidResponse{response("EUR", seat("a", 0, bid("a1", 3))), response("USD", seat("b", 0, bid("b1", 3.2)))},
+			winners:   []won{{"a1", 6}},
+			losers:    []lost{{"b1", LossLostToHigherBid}},
+		},
+		{
+			name:      "No Converter",
+			mutate:    func(r *Request) { r.Cur = []string{"USD", "EUR"} },
+			responses: []*BidResponse{response("EUR", seat("a", 0, bid("a1", 3))), response("USD", seat("b", 0, bid("b1", 2.5)))},
+			winners:   []won{{"b1", 2.5}},
+			losers:    []lost{{"a1", LossInvalidBidResponse}},
+		},
+		{
+			name: "Group Withdrawn",
+			mutate: func(r *Request) {
+				r.Imp = append(r.Imp, Imp{ID: secondImpID, Banner: &Banner{W: 320, H: 50}})
+			},
+			responses: []*BidResponse{
+				response("", seat("a", 1, bid("a1", 5), Bid{ID: "a2", ImpID: secondImpID, Price: 1})),
+				response("", seat("b", 0, Bid{ID: "b1", ImpID: secondImpID, Price: 3}), seat("c", 0, bid("c1", 4))),
+			},
+			winners: []won{{"c1", 4}, {"b1", 3}},
+			losers:  []lost{{"a1", LossLostToHigherBid}, {"a2", LossLostToHigherBid}},
+		},
+		{
+			name: "Group Won",
+			mutate: func(r *Request) {
+				r.Imp = append(r.Imp, Imp{ID: secondImpID, Banner: &Banner{W: 320, H: 50}})
+			},
+			responses: []*BidResponse{
+				response("", seat("a", 1, bid("a1", 5), Bid{ID: "a2", ImpID: secondImpID, Price: 4})),
+				response("", seat("b", 0, Bid{ID: "b1", ImpID: secondImpID, Price: 3}), seat("c", 0, bid("c1", 4))),
+			},
+			winners: []won{{"a1", 5}, {"a2", 4}},
+			losers:  []lost{{"b1", LossLostToHigherBid}, {"c1", LossLostToHigherBid}},
+		},
+		{
+			name:      "Invalid Response",
+			responses: []*BidResponse{{ID: "foo", SeatBid: []Seatbid{seat("a", 0, bid("a1", 3))}}},
+			losers:    []lost{{"a1", LossInvalidAuctionID}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := loadRequest(t, "./test_data/static_bid_request.json")
+			if tt.mutate != nil {
+				tt.mutate(req)
+			}
+
+			res, err := tt.auction.Run(req, tt.responses...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var winners []won
+			for _, w := range res.Winners {
+				if w.Reason != LossBidWon {
+					t.Errorf("winner %s has reason %v", w.Bid.ID, w.Reason)
+				}
+				winners = append(winners, won{w.Bid.ID, w.Price})
+			}
+			var losers []lost
+			for _, l := range res.Losers {
+				losers = append(losers, lost{l.Bid.ID, l.Reason})
+			}
+			if !reflect.DeepEqual(winners, tt.winners) {
+				t.Errorf("winners = %v, want %v", winners, tt.winners)
+			}
+			if !reflect.DeepEqual(losers, tt.losers) {
+				t.Errorf("losers = %v, want %v", losers, tt.losers)
+			}
+		})
+	}
+}
+
+func TestAuctionFloorCurrency(t *testing.T) {
+	req := loadRequest(t, "./test_data/static_bid_request.json")
+	req.Imp[0].BidFloorCur = "EUR"
+	if _, err := (Auction{}).Run(req); err != ErrNoConverter {
+		t.Errorf("Run() = %v, want ErrNoConverter", err)
+	}
+}
@@ -1,5 +1,7 @@
 package twofive
 
+import "errors"
+
 // Header required in RTB requests
 const (
 	Header  = "x-openrtb-version"
@@ -13,7 +15,8 @@ const (
 type Request struct {
 	ID      string     `json:"id"                valid:"-"`
 	Imp     []Imp      `json:"imp"               valid:"required"`
-	App     App        `json:"app"               valid:"required"`
+	App     *App       `json:"app,omitempty"     valid:"optional"` // exactly one of app or site is required, see ValidateInventory
+	Site    *Site      `json:"site,omitempty"    valid:"optional"`
 	Device  Device     `json:"device"            valid:"required"`
 	Format  Format     `json:"format"            valid:"required"` // this is not part of the spec, adding this here for convience allows h and width to be passed without the video/banner object to backwards support the GET
 	User    User       `json:"user"              valid:"required"`
@@ -33,10 +36,30 @@ type Request struct {
 	Ext     RequestExt `json:"ext,omitempty"     valid:"required"`
 }
 
+// errors returned by Request.ValidateInventory
+var (
+	ErrNoInventory = errors.New("twofive: request must contain an app or a site")
+	ErrAppAndSite  = errors.New("twofive: request must not contain both an app and a site")
+)
+
+// ValidateInventory checks the request describes exactly one of app or site inventory. The struct tags
+// can't express this, so it should be run alongside the validator
+func (r Request) ValidateInventory() error {
+	switch {
+	case r.App == nil && r.Site == nil:
+		return ErrNoInventory
+	case r.App != nil && r.Site != nil:
+		return ErrAppAndSite
+	}
+	return nil
+}
+
 // RequestExt used to communicate the publishers api key
 type RequestExt struct {
 	APIKey    string `json:"api_key"    valid:"uuidv4,required"`
 	SessionID string `json:"session_id" valid:"required"`
+
+	Extensions `json:"-" valid:"-"`
 }
 
 // Source describes the nature and behavior of the entity that is the source of the bid request
@@ -46,7 +69,7 @@ type RequestExt struct {
 // mediation platform, or an ad server combines direct campaigns with 3rd party demand in decisioning.
 type Source struct {
 	FD     int        `json:"fd,omitempty"     valid:"range(0|1),optional"`
-	TID    int        `json:"tid,omitempty"    valid:"-"`
+	TID    string     `json:"tid,omitempty"    valid:"-"` // a string as in the spec, it was an int
 	PChain string     `json:"pchain,omitempty" valid:"-"`
 	Ext    *SourceExt `json:"ext,omitempty"    valid:"-"`
 }
@@ -55,19 +78,26 @@ type Source struct {
 type SourceExt struct {
 	Omidpn string `json:"omidpn,omitempty" valid:"-"` // identifier of the OM SDK integration, this is the same as the "name" parameter of the OMID Partner object
 	Omidpv string `json:"omidpv,omitempty" valid:"-"` // (optional) Version of the OM SDK version
+
+	Extensions `json:"-" valid:"-"`
 }
 
 // Regs object contains any legal, governmental, or industry regulations that apply to the request. The
 // coppa flag signals whether or not the request falls under the United States Federal Trade Commission’s
 // regulations for the United States Children’s Online Privacy Protection Act (“COPPA”).
 type Regs struct {
-	Coppa int      `json:"coppa" valid:"range(0|1),optional"`
-	Ext   *RegsExt `json:"ext"   valid:"optional"`
+	Coppa  int      `json:"coppa"             valid:"range(0|1),optional"`
+	GPP    string   `json:"gpp,omitempty"     valid:"-"` // the Global Privacy Platform string
+	GPPSID []int    `json:"gpp_sid,omitempty" valid:"-"` // the sections of the GPP string that apply
+	Ext    *RegsExt `json:"ext"               valid:"optional"`
 }
 
-// RegsExt being used for GDPR
+// RegsExt being used for GDPR and the US Privacy string of the CCPA
 type RegsExt struct {
-	GDPR int `json:"gdpr" valid:"range(0|1),optional"`
+	GDPR      int    `json:"gdpr"                 valid:"range(0|1),optional"`
+	USPrivacy string `json:"us_privacy,omitempty" valid:"matches(^1[YNyn-]{3}$),optional"`
+
+	Extensions `json:"-" valid:"-"`
 }
 
 // Imp describes an ad placement or impression being auctioned. A single bid request can include
@@ -91,13 +121,10 @@ type Imp struct {
 	Ext                  *ImpExt  `json:"ext,omitempty"                  valid:"-"`
 }
 
-// ImpExt ...
+// ImpExt ... integration specific keys are registered with RegisterExt[*Imp] rather than added here, as the
+// Nimbus keys are in nimbus_ext.go
 type ImpExt struct {
-	APS           []APS  `json:"aps,omitempty"             valid:"-"`
-	GoogleID      string `json:"google_id,omitempty"       valid:"-"` // a tempary condition (experiment) to determine if google is participating in the Nimbus auction
-	FacebookAppID string `json:"facebook_app_id,omitempty" valid:"-"` // needed for pubs that have FB hybrid SDK solution in thier stack
-	Position      string `json:"position,omitempty"        valid:"-"` // flexible optional field for publishers to track on ad position performance
-	Viewability   int    `json:"viewability,omitempty"     valid:"-"` // for demand
+	Extensions `json:"-" valid:"-"`
 }
 
 // Metric is associated with an impression as an array of metrics. These metrics can offer insight into
@@ -113,7 +140,9 @@ type Metric struct {
 }
 
 // MetricExt ...
-type MetricExt struct{}
+type MetricExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Banner represents the most general type of impression. Although the term “banner” may have very
 // specific meaning in other contexts, here it can be many things including a simple static image, an
@@ -121,19 +150,21 @@ type MetricExt struct{}
 // generalized and full featured video ad units). An array of Banner objects can also appear within the
 // Video to describe optional companion ads defined in the VAST specification.
 type Banner struct {
-	BidFloor *float64   `json:"bidfloor,omitempty" valid:"-"`
-	BAttr    []int      `json:"battr,omitempty"    valid:"-"`
-	Format   []Format   `json:"format,omitempty"   valid:"optional"`
-	W        int        `json:"w,omitempty"        valid:"-"`
-	H        int        `json:"h,omitempty"        valid:"-"`
-	ID       string     `json:"id,omitempty"       valid:"optional"`
-	Pos      int        `json:"pos,omitempty"      valid:"range(0|7),optional"`            // 0,1,2,3,4,5,6,7 -> Unknown,Above the Fold,DEPRECATED - May or may not be initially visible depending on screen size/resolution.,Below the Fold,Header,Footer,Sidebar,Full Screen
-	API      []int      `json:"api,omitempty"      valid:"inintarr(1|2|3|4|5|6),optional"` // 3,5,6 -> mraid1, 2, and 3
-	Ext      *BannerExt `json:"ext,omitempty"      valid:"-"`
+	BidFloor *float64            `json:"bidfloor,omitempty" valid:"-"`
+	BAttr    []CreativeAttribute `json:"battr,omitempty"    valid:"-"`
+	Format   []Format            `json:"format,omitempty"   valid:"optional"`
+	W        int                 `json:"w,omitempty"        valid:"-"`
+	H        int                 `json:"h,omitempty"        valid:"-"`
+	ID       string              `json:"id,omitempty"       valid:"optional"`
+	Pos      AdPosition          `json:"pos,omitempty"      valid:"range(0|7),optional"`
+	API      []APIFramework      `json:"api,omitempty"      valid:"inintarr(1|2|3|4|5|6),optional"` // 3,5,6 -> mraid1, 2, and 3
+	Ext      *BannerExt          `json:"ext,omitempty"      valid:"-"`
 }
 
 // BannerExt ...
-type BannerExt struct{}
+type BannerExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Video object represents an in-stream video impression. Many of the fields are non-essential for minimally
 // viable transactions, but are included to offer fine control when needed. Video in OpenRTB generally
@@ -141,29 +172,31 @@ type BannerExt struct{}
 // optionally including an array of Banner objects (refer to the Banner object in Section 3.2.3) that define
 // these companion ads.
 type Video struct {
-	BidFloor       *float64  `json:"bidfloor,omitempty"       valid:"-"`
-	Mimes          []string  `json:"mimes,omitempty"          valid:"-"`
-	Minduration    int       `json:"minduration"              valid:"-"`
-	Maxduration    int       `json:"maxduration,omitempty"    valid:"-"`
-	Protocols      []int     `json:"protocols,omitempty"      valid:"inintarr(2|3|5|6),optional"` // 1,2,3,4,5,6,7,8,9,10 -> VAST 1.0,VAST 2.0,VAST 3.0,VAST 1.0 Wrapper,VAST 2.0 Wrapper,VAST 3.0 Wrapper,VAST 4.0,VAST 4.0 Wrapper,DAAST 1.0,DAAST 1.0 Wrapper
-	W              int       `json:"w,omitempty"              valid:"-"`
-	H              int       `json:"h,omitempty"              valid:"-"`
-	StartDelay     int       `json:"startdelay"               valid:"-"`
-	Placement      int       `json:"placement,omitempty"      valid:"range(1|5),optional"`            // 1,2,3,4,5 -> In-Stream, In-Banner, In-Article, In-Feed - Found in content, social, or product feeds, Interstitial/Slider/Floating
-	Linearity      int       `json:"linearity,omitempty"      valid:"range(1|2),optional"`            // 1,2 -> linear, non linear
-	Playbackmethod []int     `json:"playbackmethod,omitempty" valid:"inintarr(1|2|3|4|5|6),optional"` // 1,2,3,4,5,6 - > Initiates on Page Load with Sound On, Initiates on Page Load with Sound Off by Default, Initiates on Click with Sound On, Initiates on Mouse-Over with Sound On, Initiates on Entering Viewport with Sound On, Initiates on Entering Viewport with Sound Off by Default
-	Skip           int       `json:"skip"                     valid:"range(0|1),optional"`            // 0 no 1 yes
-	Delivery       []int     `json:"Delivery,omitempty"       valid:"range(0|3),optional"`            // 0,1,2,3 -> Unknown, Professionally Produced, Prosumer, User Generated (UGC)
-	Pos            int       `json:"pos,omitempty"            valid:"range(0|7),optional"`            // 0,1,2,3,4,5,6,7 -> Unknown,Above the Fold,DEPRECATED - May or may not be initially visible depending on screen size/resolution.,Below the Fold,Header,Footer,Sidebar,Full Screen
-	API            []int     `json:"api,omitempty"            valid:"inintarr(1|2|3|4|5|6),optional"` // current version of the IMA player doesn't support vpaid 2. So pass in the value 1 or nothing -> refer to list 5.12
-	MinBitRate     int       `json:"minbitrate,omitempty"     valid:"-"`
-	MaxBitRate     int       `json:"maxbitrate,omitempty"     valid:"-"`
-	Boxingallowed  int       `json:"boxingallowed,omitempty"  valid:"range(0|1),optional"`
-	Ext            *VideoExt `json:"ext,omitempty"            valid:"-"`
+	BidFloor       *float64          `json:"bidfloor,omitempty"       valid:"-"`
+	Mimes          []string          `json:"mimes,omitempty"          valid:"-"`
+	Minduration    int               `json:"minduration"              valid:"-"`
+	Maxduration    int               `json:"maxduration,omitempty"    valid:"-"`
+	Protocols      []Protocol        `json:"protocols,omitempty"      valid:"inintarr(2|3|5|6),optional"`
+	W              int               `json:"w,omitempty"              valid:"-"`
+	H              int               `json:"h,omitempty"              valid:"-"`
+	StartDelay     StartDelay        `json:"startdelay"               valid:"-"`
+	Placement      VideoPlacement    `json:"placement,omitempty"      valid:"range(1|5),optional"`
+	Linearity      VideoLinearity    `json:"linearity,omitempty"      valid:"range(1|2),optional"`
+	Playbackmethod []PlaybackMethod  `json:"playbackmethod,omitempty" valid:"inintarr(1|2|3|4|5|6),optional"`
+	Skip           int               `json:"skip"                     valid:"range(0|1),optional"` // 0 no 1 yes
+	Delivery       []ContentDelivery `json:"Delivery,omitempty"       valid:"range(0|3),optional"`
+	Pos            AdPosition        `json:"pos,omitempty"            valid:"range(0|7),optional"`
+	API            []APIFramework    `json:"api,omitempty"            valid:"inintarr(1|2|3|4|5|6),optional"` // current version of the IMA player doesn't support vpaid 2. So pass in the value 1 or nothing
+	MinBitRate     int               `json:"minbitrate,omitempty"     valid:"-"`
+	MaxBitRate     int               `json:"maxbitrate,omitempty"     valid:"-"`
+	Boxingallowed  int               `json:"boxingallowed,omitempty"  valid:"range(0|1),optional"`
+	Ext            *VideoExt         `json:"ext,omitempty"            valid:"-"`
 }
 
 // VideoExt ...
-type VideoExt struct{}
+type VideoExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Audio object represents an audio type impression. Many of the fields are non-essential for minimally
 // viable transactions, but are included to offer fine control when needed. Audio in OpenRTB generally
@@ -171,43 +204,47 @@ type VideoExt struct{}
 // optionally including an array of Banner objects (refer to the Banner object in Section 3.2.3) that define
 // these companion ads.
 type Audio struct {
-	Mimes         []string  `json:"mimes,omitempty"         valid:"required"`
-	Minduration   int       `json:"minduration"             valid:"-"`
-	Maxduration   int       `json:"maxduration,omitempty"   valid:"-"`
-	Protocols     []int     `json:"protocols,omitempty"     valid:"-"`
-	StartDelay    int       `json:"startdelay"              valid:"-"`
-	Sequence      int       `json:"sequence,omitempty"      valid:"-"`
-	Battr         []int     `json:"battr,omitempty"         valid:"-"`
-	MaxExtended   int       `json:"maxextended,omitempty"   valid:"-"`
-	MinBitRate    int       `json:"minbitrate,omitempty"    valid:"-"`
-	MaxBitRate    int       `json:"maxbitrate,omitempty"    valid:"-"`
-	Delivery      []int     `json:"delivery,omitempty"      valid:"-"`
-	CompanionAd   []Banner  `json:"companionad,omitempty"   valid:"-"`
-	API           []int     `json:"api,omitempty"           valid:"-"`
-	CompanionType []int     `json:"companiontype,omitempty" valid:"-"`
-	Maxseq        int       `json:"maxseq,omitempty"        valid:"-"`
-	Feed          int       `json:"feed,omitempty"          valid:"range(1|3),optional"` // 1,2,3 -> Music Service, FM/AM Broadcast, Podcast
-	Stitched      int       `json:"stitched,omitempty"      valid:"range(0|1),optional"`
-	Nvol          int       `json:"nvol,omitempty"          valid:"range(0|4),optional"` // 0,1,2,3,4 -> None, Ad Volume Average Normalized to Content, Ad Volume Peak Normalized to Content, Ad Loudness Normalized to Content, Custom Volume Normalization
-	Ext           *AudioExt `json:"ext,omitempty"           valid:"-"`
+	Mimes         []string            `json:"mimes,omitempty"         valid:"required"`
+	Minduration   int                 `json:"minduration"             valid:"-"`
+	Maxduration   int                 `json:"maxduration,omitempty"   valid:"-"`
+	Protocols     []Protocol          `json:"protocols,omitempty"     valid:"-"`
+	StartDelay    StartDelay          `json:"startdelay"              valid:"-"`
+	Sequence      int                 `json:"sequence,omitempty"      valid:"-"`
+	Battr         []CreativeAttribute `json:"battr,omitempty"         valid:"-"`
+	MaxExtended   int                 `json:"maxextended,omitempty"   valid:"-"`
+	MinBitRate    int                 `json:"minbitrate,omitempty"    valid:"-"`
+	MaxBitRate    int                 `json:"maxbitrate,omitempty"    valid:"-"`
+	Delivery      []ContentDelivery   `json:"delivery,omitempty"      valid:"-"`
+	CompanionAd   []Banner            `json:"companionad,omitempty"   valid:"-"`
+	API           []APIFramework      `json:"api,omitempty"           valid:"-"`
+	CompanionType []CompanionType     `json:"companiontype,omitempty" valid:"-"`
+	Maxseq        int                 `json:"maxseq,omitempty"        valid:"-"`
+	Feed          FeedType            `json:"feed,omitempty"          valid:"range(1|3),optional"`
+	Stitched      int                 `json:"stitched,omitempty"      valid:"range(0|1),optional"`
+	Nvol          VolumeNormalization `json:"nvol,omitempty"          valid:"range(0|4),optional"`
+	Ext           *AudioExt           `json:"ext,omitempty"           valid:"-"`
 }
 
 // AudioExt ...
-type AudioExt struct{}
+type AudioExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Native object represents a native type impression. Native ad units are intended to blend seamlessly into
 // the surrounding content (e.g., a sponsored Twitter or Facebook post). As such, the response must be
 // well-structured to afford the publisher fine-grained control over rendering.
 type Native struct {
-	Request string     `json:"request"         valid:"required"`
-	Ver     string     `json:"ver,omitempty"   valid:"-"`
-	API     []int      `json:"api,omitempty"   valid:"-"`
-	Battr   []int      `json:"battr,omitempty" valid:"-"`
-	Ext     *NativeExt `json:"ext,omitempty"   valid:"-"`
+	Request string              `json:"request"         valid:"required"`
+	Ver     string              `json:"ver,omitempty"   valid:"-"`
+	API     []APIFramework      `json:"api,omitempty"   valid:"-"`
+	Battr   []CreativeAttribute `json:"battr,omitempty" valid:"-"`
+	Ext     *NativeExt          `json:"ext,omitempty"   valid:"-"`
 }
 
 // NativeExt ...
-type NativeExt struct{}
+type NativeExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Format object represents an allowed size (i.e., height and width combination) for a banner impression.
 // These are typically used in an array for an impression where multiple sizes are permitted.
@@ -221,7 +258,9 @@ type Format struct {
 }
 
 // FormatExt ...
-type FormatExt struct{}
+type FormatExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // App object should be included if the ad supported content is a non-browser application (typically in
 // mobile) as opposed to a website. A bid request must not contain both an App and a Site object. At a
@@ -245,7 +284,36 @@ type App struct {
 }
 
 // AppExt ...
-type AppExt struct{}
+type AppExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// Site object should be included if the ad supported content is a website as opposed to a non-browser
+// application. A bid request must not contain both a Site and an App object. At a minimum, it is useful to
+// provide a site ID or page URL, but this is not strictly required
+type Site struct {
+	ID            string    `json:"id,omitempty"            valid:"-"`
+	Name          string    `json:"name,omitempty"          valid:"-"`
+	Domain        string    `json:"domain,omitempty"        valid:"-"`
+	Cat           []string  `json:"cat,omitempty"           valid:"-"`
+	SectionCat    []string  `json:"sectioncat,omitempty"    valid:"-"`
+	PageCat       []string  `json:"pagecat,omitempty"       valid:"-"`
+	Page          string    `json:"page,omitempty"          valid:"-"`                   // URL of the page where the impression will be shown
+	Ref           string    `json:"ref,omitempty"           valid:"-"`                   // referrer URL that caused navigation to the current page
+	Search        string    `json:"search,omitempty"        valid:"-"`                   // search string that caused navigation to the current page
+	Mobile        int       `json:"mobile,omitempty"        valid:"range(0|1),optional"` // 0 = not mobile optimized, 1 = mobile optimized
+	AMP           int       `json:"amp,omitempty"           valid:"range(0|1),optional"` // 0 = not an AMP page, 1 = AMP page
+	PrivacyPolicy int       `json:"privacypolicy,omitempty" valid:"range(0|1),optional"` // no policy 0 policy 1
+	Publisher     Publisher `json:"publisher"               valid:"required"`
+	Content       *Content  `json:"content,omitempty"       valid:"-"`
+	Keywords      []string  `json:"keywords,omitempty"      valid:"-"`
+	Ext           *SiteExt  `json:"ext,omitempty"           valid:"-"`
+}
+
+// SiteExt ...
+type SiteExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Publisher object describes the publisher of the media in which the ad will be displayed. The publisher is
 // typically the seller in an OpenRTB transaction.
@@ -258,7 +326,9 @@ type Publisher struct {
 }
 
 // PublisherExt ...
-type PublisherExt struct{}
+type PublisherExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // APS is the response Object the APS sdk generates
 // this should just pass through the information the clients sent
@@ -276,35 +346,37 @@ type APS struct {
 // content. This object may be useful when syndicated content contains impressions and does
 // not necessarily match the publisher’s general content.
 type Content struct {
-	ID                 string      `json:"id,omitempty"                 valid:"-"`
-	Episode            int         `json:"episode,omitempty"            valid:"-"`
-	Title              string      `json:"title,omitempty"              valid:"-"`
-	Series             string      `json:"series,omitempty"             valid:"-"`
-	Season             string      `json:"season,omitempty"             valid:"-"`
-	Artist             string      `json:"artist,omitempty"             valid:"-"`
-	Genre              string      `json:"genre,omitempty"              valid:"-"`
-	Album              string      `json:"album,omitempty"              valid:"-"`
-	ISRC               string      `json:"isrc,omitempty"               valid:"-"`
-	Producer           *Producer   `json:"producer,omitempty"           valid:"-"`
-	URL                string      `json:"url,omitempty"                valid:"-"`
-	Cat                []string    `json:"cat,omitempty"                valid:"-"`
-	Prodq              int         `json:"prodq,omitempty"              valid:"range(0|3),optional"` // 0,1,2,3 -> Unknown, Professionally Produced, Prosumer, User Generated (UGC)
-	Context            int         `json:"context,omitempty"            valid:"range(1|7),optional"` // 1,2,3,4,5,6,7 -> Video (i.e., video file or stream such as Internet TV broadcasts),Game (i.e., an interactive software game),Music (i.e., audio file or stream such as Internet radio broadcasts),Application (i.e., an interactive software application),Text (i.e., primarily textual document such as a web page, eBook, or news article),Other (i.e., none of the other categories applies),Unknown
-	ContentRating      string      `json:"contentrating,omitempty"      valid:"-"`
-	UserRating         string      `json:"userrating,omitempty"         valid:"-"`
-	QagMediaRating     int         `json:"qagmediarating,omitempty"     valid:"range(1|3),optional"` // 1,2,3 -> All Audiences, Everyone Over 12, Mature Audiences
-	Keywords           []string    `json:"keywords,omitempty"           valid:"-"`
-	LiveStream         int         `json:"livestream,omitempty"         valid:"range(0|1),optional"` // 0 = not live, 1 = live
-	SourceRelationShip int         `json:"sourcerelationship,omitempty" valid:"range(0|1),optional"` // 0 = indirect, 1 = direct
-	Len                int         `json:"len,omitempty"                valid:"-"`
-	Language           string      `json:"language,omitempty"           valid:"-"`
-	Embeddable         int         `json:"embeddable,omitempty"         valid:"-"`
-	Data               []Data      `json:"data,omitempty"               valid:"-"`
-	Ext                *ContentExt `json:"ext,omitempty"                valid:"-"`
+	ID                 string            `json:"id,omitempty"                 valid:"-"`
+	Episode            int               `json:"episode,omitempty"            valid:"-"`
+	Title              string            `json:"title,omitempty"              valid:"-"`
+	Series             string            `json:"series,omitempty"             valid:"-"`
+	Season             string            `json:"season,omitempty"             valid:"-"`
+	Artist             string            `json:"artist,omitempty"             valid:"-"`
+	Genre              string            `json:"genre,omitempty"              valid:"-"`
+	Album              string            `json:"album,omitempty"              valid:"-"`
+	ISRC               string            `json:"isrc,omitempty"               valid:"-"`
+	Producer           *Producer         `json:"producer,omitempty"           valid:"-"`
+	URL                string            `json:"url,omitempty"                valid:"-"`
+	Cat                []string          `json:"cat,omitempty"                valid:"-"`
+	Prodq              ProductionQuality `json:"prodq,omitempty"              valid:"range(0|3),optional"`
+	Context            ContentContext    `json:"context,omitempty"            valid:"range(1|7),optional"`
+	ContentRating      string            `json:"contentrating,omitempty"      valid:"-"`
+	UserRating         string            `json:"userrating,omitempty"         valid:"-"`
+	QagMediaRating     QAGMediaRating    `json:"qagmediarating,omitempty"     valid:"range(1|3),optional"`
+	Keywords           []string          `json:"keywords,omitempty"           valid:"-"`
+	LiveStream         int               `json:"livestream,omitempty"         valid:"range(0|1),optional"` // 0 = not live, 1 = live
+	SourceRelationShip int               `json:"sourcerelationship,omitempty" valid:"range(0|1),optional"` // 0 = indirect, 1 = direct
+	Len                int               `json:"len,omitempty"                valid:"-"`
+	Language           string            `json:"language,omitempty"           valid:"-"`
+	Embeddable         int               `json:"embeddable,omitempty"         valid:"-"`
+	Data               []Data            `json:"data,omitempty"               valid:"-"`
+	Ext                *ContentExt       `json:"ext,omitempty"                valid:"-"`
 }
 
 // ContentExt ...
-type ContentExt struct{}
+type ContentExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Producer object defines the producer of the content in which the ad will be shown. This is particularly useful
 // when the content is syndicated and may be distributed through different publishers and thus when the
@@ -318,57 +390,63 @@ type Producer struct {
 }
 
 // ProducerExt ...
-type ProducerExt struct{}
+type ProducerExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Device object provides information pertaining to the device through which the user is interacting. Device
 // information includes its hardware, platform, location, and carrier data. The device can refer to a mobile
 // handset, a desktop computer, set top box, or other digital device.
 type Device struct {
-	Ua             string     `json:"ua"                        valid:"required"`
-	Geo            *Geo       `json:"geo,omitempty"             valid:"optional"`
-	Dnt            int        `json:"dnt"                       valid:"range(0|1),optional"` // 0 = tracking is unrestricted, 1 = tracking is restricted
-	Lmt            int        `json:"lmt"                       valid:"range(0|1),optional"` // 0 = tracking is unrestricted, 1 = tracking must be limited by commericial guidelines
-	IP             string     `json:"ip"                        valid:"ipv4,required"`
-	IPv6           string     `json:"ipv6,omitempty"            valid:"ipv6,optional"`
-	DeviceType     int        `json:"device_type,omitempty"     valid:"-"`
-	Make           string     `json:"make,omitempty"            valid:"in(Apple|Android|apple|android),required"`
-	Model          string     `json:"model,omitempty"           valid:"-"`
-	OS             string     `json:"os,omitempty"              valid:"-"`
-	OSV            string     `json:"osv,omitempty"             valid:"-"`
-	HWV            string     `json:"hwv,omitempty"             valid:"-"`
-	H              int        `json:"h,omitempty"               valid:"-"`
-	W              int        `json:"w,omitempty"               valid:"-"`
-	PPI            int        `json:"ppi,omitempty"             valid:"-"`
-	PXRatio        float64    `json:"px_ratio,omitempty"        valid:"-"`
-	JS             int        `json:"js,omitempty"              valid:"-"`
-	GeoFetch       int        `json:"geo_fetch,omitempty"       valid:"range(0|1),optional"` // 0 = no, 1 = yes
-	FlashVer       string     `json:"flash_ver,omitempty"       valid:"-"`
-	Language       string     `json:"language,omitempty"        valid:"-"`
-	Carrier        string     `json:"carrier,omitempty"         valid:"-"`
-	ConnectionType int        `json:"connectiontype,omitempty" valid:"-"`
-	Ifa            string     `json:"ifa"                       valid:"required"`
-	Ext            *DeviceExt `json:"ext,omitempty"             valid:"-"`
+	Ua             string         `json:"ua"                        valid:"required"`
+	Geo            *Geo           `json:"geo,omitempty"             valid:"optional"`
+	Dnt            int            `json:"dnt"                       valid:"range(0|1),optional"` // 0 = tracking is unrestricted, 1 = tracking is restricted
+	Lmt            int            `json:"lmt"                       valid:"range(0|1),optional"` // 0 = tracking is unrestricted, 1 = tracking must be limited by commericial guidelines
+	IP             string         `json:"ip"                        valid:"ipv4,required"`
+	IPv6           string         `json:"ipv6,omitempty"            valid:"ipv6,optional"`
+	DeviceType     DeviceType     `json:"device_type,omitempty"     valid:"-"`
+	Make           string         `json:"make,omitempty"            valid:"in(Apple|Android|apple|android),required"`
+	Model          string         `json:"model,omitempty"           valid:"-"`
+	OS             string         `json:"os,omitempty"              valid:"-"`
+	OSV            string         `json:"osv,omitempty"             valid:"-"`
+	HWV            string         `json:"hwv,omitempty"             valid:"-"`
+	H              int            `json:"h,omitempty"               valid:"-"`
+	W              int            `json:"w,omitempty"               valid:"-"`
+	PPI            int            `json:"ppi,omitempty"             valid:"-"`
+	PXRatio        float64        `json:"px_ratio,omitempty"        valid:"-"`
+	JS             int            `json:"js,omitempty"              valid:"-"`
+	GeoFetch       int            `json:"geo_fetch,omitempty"       valid:"range(0|1),optional"` // 0 = no, 1 = yes
+	FlashVer       string         `json:"flash_ver,omitempty"       valid:"-"`
+	Language       string         `json:"language,omitempty"        valid:"-"`
+	Carrier        string         `json:"carrier,omitempty"         valid:"-"`
+	ConnectionType ConnectionType `json:"connectiontype,omitempty" valid:"-"`
+	Ifa            string         `json:"ifa"                       valid:"required"`
+	Ext            *DeviceExt     `json:"ext,omitempty"             valid:"-"`
 }
 
 // DeviceExt ...
-type DeviceExt struct{}
+type DeviceExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Geo object encapsulates various methods for specifying a geographic location. When subordinate to a
 // Device object, it indicates the location of the device which can also be interpreted as the user’s current
 // location. When subordinate to a User object, it indicates the location of the user’s home base (i.e., not
 // necessarily their current location).
 type Geo struct {
-	Lat       float64 `json:"lat,omitempty"       valid:"-"`
-	Lon       float64 `json:"lon,omitempty"       valid:"-"`
-	Type      int     `json:"type,omitempty"      valid:"range(1|3),optional"`    // 1,2,3 -> GPS/Location Services, IP Address, User provided (e.g., registration data)
-	IPService int     `json:"ipservice,omitempty" valid:"range(1|4),optional"`    // 1,2,3,4 -> ip2location, Neustar (Quova), MaxMind, NetAcuity (Digital Element)
-	Country   string  `json:"country,omitempty"   valid:"ISO3166Alpha3,optional"` // alpha 3
-	City      string  `json:"city,omitempty"      valid:"-"`
-	Ext       *GeoExt `json:"ext,omitempty"       valid:"-"`
+	Lat       float64           `json:"lat,omitempty"       valid:"-"`
+	Lon       float64           `json:"lon,omitempty"       valid:"-"`
+	Type      LocationType      `json:"type,omitempty"      valid:"range(1|3),optional"`
+	IPService IPLocationService `json:"ipservice,omitempty" valid:"range(1|4),optional"`
+	Country   string            `json:"country,omitempty"   valid:"ISO3166Alpha3,optional"` // alpha 3
+	City      string            `json:"city,omitempty"      valid:"-"`
+	Ext       *GeoExt           `json:"ext,omitempty"       valid:"-"`
 }
 
 // GeoExt ...
-type GeoExt struct{}
+type GeoExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // User object contains information known or derived about the human user of the device (i.e., the
 // audience for advertising). The user id is an exchange artifact and may be subject to rotation or other
@@ -392,6 +470,8 @@ type UserExt struct {
 	Consent    string `json:"consent,omitempty" valid:"base64rawstring,optional"`
 	Age        int    `json:"age,omitempty" valid:"_"`                           // age used incase year of birth can't be passed and age can
 	DidConsent int    `json:"did_consent,omitempty" valid:"range(0|1),optional"` // extention for apps to indicate a user accepted gdpr
+
+	Extensions `json:"-" valid:"-"`
 }
 
 // Data and Segment objects together allow additional data about the user to be specified. This data
@@ -406,7 +486,9 @@ type Data struct {
 }
 
 // DataExt ...
-type DataExt struct{}
+type DataExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Segment objects are essentially key-value pairs that convey specific units of data about the user. The
 // parent Data object is a collection of such values from a given data provider. The specific segment
@@ -419,7 +501,9 @@ type Segment struct {
 }
 
 // SegmentExt ...
-type SegmentExt struct{}
+type SegmentExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // PMP object is the private marketplace container for direct deals between buyers and sellers that may
 // pertain to this impression. The actual deals are represented as a collection of Deal objects. Refer to
@@ -431,7 +515,9 @@ type PMP struct {
 }
 
 // PMPExt ...
-type PMPExt struct{}
+type PMPExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Deal object constitutes a specific deal that was struck a priori between a buyer and a seller. Its presence
 // with the Pmp collection indicates that this impression is available under the terms of that deal. Refer to
@@ -447,4 +533,6 @@ type Deal struct {
 }
 
 // DealExt ...
-type DealExt struct{}
+type DealExt struct {
+	Extensions `json:"-" valid:"-"`
+}
@@ -166,3 +166,59 @@ func TestBidRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestBidRequestInventory(t *testing.T) {
+
+	staticBidRequest, err := ioutil.ReadFile("./test_data/static_bid_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	siteBidRequest, err := ioutil.ReadFile("./test_data/site_bid_request.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		bidRequest []byte
+		mutate     func(r *Request)
+		want       error
+	}{
+		{
+			name:       "App Bid Request",
+			bidRequest: staticBidRequest,
+		},
+		{
+			name:       "Site Bid Request",
+			bidRequest: siteBidRequest,
+		},
+		{
+			name:       "No App Or Site",
+			bidRequest: staticBidRequest,
+			mutate:     func(r *Request) { r.App = nil },
+			want:       ErrNoInventory,
+		},
+		{
+			name:       "App And Site",
+			bidRequest: siteBidRequest,
+			mutate:     func(r *Request) { r.App = &App{} },
+			want:       ErrAppAndSite,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r Request
+			if err := json.Unmarshal(tt.bidRequest, &r); err != nil {
+				t.Fatal(err)
+			}
+			if tt.mutate != nil {
+				tt.mutate(&r)
+			}
+			if err := r.ValidateInventory(); err != tt.want {
+				t.Errorf("ValidateInventory() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -11,12 +11,14 @@ type BidResponse struct {
 	BidID      string         `json:"bidid"`
 	Cur        string         `json:"cur"`
 	CustomData string         `json:"customdata"`
-	NBR        int            `json:"nbr"`
+	NBR        NoBidReason    `json:"nbr"`
 	Ext        BidResponseExt `json:"ext"`
 }
 
 // BidResponseExt ...
-type BidResponseExt struct{}
+type BidResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Seatbid -> A bid response can contain multiple SeatBid objects, each on behalf of a different bidder seat and each
 // containing one or more individual bids. If multiple impressions are presented in the request, the group
@@ -30,39 +32,43 @@ type Seatbid struct {
 }
 
 // SeatbidExt ...
-type SeatbidExt struct{}
+type SeatbidExt struct {
+	Extensions `json:"-" valid:"-"`
+}
 
 // Bid -> A SeatBid object contains one or more Bid objects, each of which relates to a specific impression in the
 // bid request via the impid attribute and constitutes an offer to buy that impression for a given price.
 type Bid struct {
-	ID             string   `json:"id"`
-	ImpID          string   `json:"impid"`
-	Price          float64  `json:"price"`
-	NURL           string   `json:"nurl"`
-	BURL           string   `json:"burl"`
-	LURL           string   `json:"lurl"`
-	Adm            string   `json:"adm"`
-	Adid           string   `json:"adid"`
-	Adomain        []string `json:"adomain"`
-	Bundle         string   `json:"bundle"`
-	IURL           string   `json:"iurl"`
-	Cid            string   `json:"cid"`
-	Crid           string   `json:"crid"`
-	Tactic         string   `json:"tactic"`
-	Cat            []string `json:"cat"`
-	Attr           []int    `json:"attr"`
-	API            int      `json:"api"`
-	Protocol       int      `json:"protocol"`
-	QagMediaRating int      `json:"qagmediarating"`
-	Language       string   `json:"language"`
-	DealID         string   `json:"dealid"`
-	W              int      `json:"w"`
-	H              int      `json:"h"`
-	WRatio         int      `json:"wratio"`
-	HRatio         int      `json:"hratio"`
-	Exp            int      `json:"exp"`
-	Ext            BidExt   `json:"ext"`
+	ID             string              `json:"id"`
+	ImpID          string              `json:"impid"`
+	Price          float64             `json:"price"`
+	NURL           string              `json:"nurl"`
+	BURL           string              `json:"burl"`
+	LURL           string              `json:"lurl"`
+	Adm            string              `json:"adm"`
+	Adid           string              `json:"adid"`
+	Adomain        []string            `json:"adomain"`
+	Bundle         string              `json:"bundle"`
+	IURL           string              `json:"iurl"`
+	Cid            string              `json:"cid"`
+	Crid           string              `json:"crid"`
+	Tactic         string              `json:"tactic"`
+	Cat            []string            `json:"cat"`
+	Attr           []CreativeAttribute `json:"attr"`
+	API            APIFramework        `json:"api"`
+	Protocol       Protocol            `json:"protocol"`
+	QagMediaRating QAGMediaRating      `json:"qagmediarating"`
+	Language       string              `json:"language"`
+	DealID         string              `json:"dealid"`
+	W              int                 `json:"w"`
+	H              int                 `json:"h"`
+	WRatio         int                 `json:"wratio"`
+	HRatio         int                 `json:"hratio"`
+	Exp            int                 `json:"exp"`
+	Ext            BidExt              `json:"ext"`
 }
 
 // BidExt ...
-type BidExt struct{}
+type BidExt struct {
+	Extensions `json:"-" valid:"-"`
+}
@@ -16,6 +16,9 @@ func (c creatives) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
 func (c creatives) Less(i, j int) bool { return c[i].Bid > c[j].Bid }
 
 // GetVastCreative parses the highest paying creative from a potential list
+//
+// Deprecated: GetVastCreative ignores the impressions, deals, floors and auction type of the request, use an
+// Auction to pick the winner of each impression instead
 func (b BidResponse) GetVastCreative() *Creative {
 	var c []Creative
 	for _, s := range b.SeatBid {
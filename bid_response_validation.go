@@ -0,0 +1,210 @@
+package twofive
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency assumed when a request, impression or response doesn't specify one
+const DefaultCurrency = "USD"
+
+// BidRejection is a bid that failed validation against the request it answers. The embedded ValidationError
+// points at the field that failed, and Reason is the loss reason the bid should be reported with
+type BidRejection struct {
+	ValidationError
+	Seat   string     `json:"seat,omitempty"`
+	BidID  string     `json:"bidid"`
+	ImpID  string     `json:"impid"`
+	Reason LossReason `json:"reason"`
+}
+
+func (r BidRejection) Error() string {
+	return fmt.Sprintf("bid %s rejected, %s: %s", r.BidID, r.Reason, r.ValidationError.Error())
+}
+
+// BidRejections is every bid of a response that failed validation, each bid is rejected at most once
+type BidRejections []BidRejection
+
+func (b BidRejections) Error() string {
+	s := make([]string, len(b))
+	for i, r := range b {
+		s[i] = r.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// ValidateResponse checks a bid response against the request it answers. A nil error means every bid is
+// valid, otherwise the error is always of type BidRejections holding the first failed check of each invalid
+// bid. Floors are only compared when the response is in the currency of the floor, no conversion is attempted.
+// Deal floors are taken to be in the currency of the impression floor, the bidfloorcur of a deal being a number
+// in this model that can't name one. Bids for native impressions also have their markup checked with
+// ValidateNative
+func ValidateResponse(req *Request, resp *BidResponse) error {
+	imps := make(map[string]*Imp, len(req.Imp))
+	for i := range req.Imp {
+		imps[req.Imp[i].ID] = &req.Imp[i]
+	}
+
+	cur := resp.Cur
+	if cur == "" {
+		cur = DefaultCurrency
+	}
+	allowed := req.Cur
+	if len(allowed) == 0 {
+		allowed = []string{DefaultCurrency}
+	}
+
+	var rejections BidRejections
+	for i, sb := range resp.SeatBid {
+		seatPath := "seatbid[" + strconv.Itoa(i) + "]"
+		for j := range sb.Bid {
+			bid := &sb.Bid[j]
+			path := seatPath + ".bid[" + strconv.Itoa(j) + "]"
+
+			var reject *BidRejection
+			switch {
+			case resp.ID != req.ID:
+				reject = rejection(LossInvalidAuctionID, "id", "eqfield(request.id)", resp.ID)
+			case !containsFold(allowed, cur):
+				reject = rejection(LossInvalidBidResponse, "cur", "in("+strings.Join(allowed, "|")+")", cur)
+			case len(req.WSeat) > 0 && !contains(req.WSeat, sb.Seat):
+				reject = rejection(LossBuyerSeatBlocked, seatPath+".seat", "in(wseat)", sb.Seat)
+			case contains(req.BSeat, sb.Seat):
+				reject = rejection(LossBuyerSeatBlocked, seatPath+".seat", "notin(bseat)", sb.Seat)
+			default:
+				reject = validateBid(req, imps[bid.ImpID], bid, sb.Seat, cur, path)
+			}
+
+			if reject != nil {
+				reject.Seat, reject.BidID, reject.ImpID = sb.Seat, bid.ID, bid.ImpID
+				rejections = append(rejections, *reject)
+			}
+		}
+	}
+
+	if len(rejections) == 0 {
+		return nil
+	}
+	return rejections
+}
+
+// validateBid checks a single bid against the impression it references, imp is nil if the bid references an
+// impression that isn't in the request
+func validateBid(req *Request, imp *Imp, bid *Bid, seat, cur, path string) *BidRejection {
+	if imp == nil {
+		return rejection(LossInvalidBidResponse, path+".impid", "in(imp.id)", bid.ImpID)
+	}
+
+	var deal *Deal
+	if bid.DealID != "" {
+		if imp.PMP != nil {
+			for i := range imp.PMP.Deals {
+				if imp.PMP.Deals[i].ID == bid.DealID {
+					deal = &imp.PMP.Deals[i]
+					break
+				}
+			}
+		}
+		if deal == nil {
+			return rejection(LossInvalidDealID, path+".dealid", "in(imp.pmp.deals.id)", bid.DealID)
+		}
+		if len(deal.WSeat) > 0 && !contains(deal.WSeat, seat) {
+			return rejection(LossBuyerSeatBlocked, path+".dealid", "in(deal.wseat)", seat)
+		}
+	}
+
+	// the floor of a deal is in the currency of the imp, see ValidateResponse
+	floorCur := imp.BidFloorCur
+	if floorCur == "" {
+		floorCur = DefaultCurrency
+	}
+	if strings.EqualFold(floorCur, cur) {
+		if deal != nil && bid.Price < deal.BidFloor {
+			return rejection(LossBelowDealFloor, path+".price", "gte(deal.bidfloor)", bid.Price)
+		}
+		if deal == nil && bid.Price < imp.BidFloor {
+			return rejection(LossBelowAuctionFloor, path+".price", "gte(imp.bidfloor)", bid.Price)
+		}
+	}
+
+	for i, d := range bid.Adomain {
+		if domainBlocked(req.BAdv, d) {
+			return rejection(LossAdvertiserExclusions, path+".adomain["+strconv.Itoa(i)+"]", "notin(badv)", d)
+		}
+	}
+	if bid.Bundle != "" && contains(req.BApp, bid.Bundle) {
+		return rejection(LossAppBundleExclusions, path+".bundle", "notin(bapp)", bid.Bundle)
+	}
+	for i, c := range bid.Cat {
+		if containsFold(req.Bcat, c) {
+			return rejection(LossCategoryExclusions, path+".cat["+strconv.Itoa(i)+"]", "notin(bcat)", c)
+		}
+	}
+
+	var battr []CreativeAttribute
+	switch {
+	case imp.Banner != nil:
+		battr = imp.Banner.BAttr
+	case imp.Audio != nil:
+		battr = imp.Audio.Battr
+	case imp.Native != nil:
+		battr = imp.Native.Battr
+	}
+	for i, a := range bid.Attr {
+		for _, b := range battr {
+			if a == b {
+				return rejection(LossCreativeAttributeExclusions, path+".attr["+strconv.Itoa(i)+"]", "notin(battr)", a)
+			}
+		}
+	}
+
+	// a native request that can't be parsed is the fault of the request, not the bid
+	if imp.Native != nil {
+		if errs, ok := ValidateNative(*imp.Native, *bid).(ValidationErrors); ok {
+			admPath := path + ".adm"
+			if errs[0].Path != "adm" {
+				admPath += "." + errs[0].Path
+			}
+			return rejection(LossCreativeIncorrectFormat, admPath, errs[0].Rule, errs[0].Value)
+		}
+	}
+	return nil
+}
+
+func rejection(reason LossReason, path, rule string, value interface{}) *BidRejection {
+	return &BidRejection{
+		ValidationError: ValidationError{Path: path, Rule: rule, Value: value},
+		Reason:          reason,
+	}
+}
+
+// domainBlocked reports if domain, or any of its parent domains, are in the block list
+func domainBlocked(blocked []string, domain string) bool {
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	for _, b := range blocked {
+		b = strings.TrimSuffix(strings.ToLower(b), ".")
+		if b != "" && (domain == b || strings.HasSuffix(domain, "."+b)) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, l := range list {
+		if strings.EqualFold(l, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+package twofive
+
+import "testing"
+
+func TestValidateResponse(t *testing.T) {
+	newResponse := func() *BidResponse {
+		return &BidResponse{
+			ID: staticImpID,
+			SeatBid: []Seatbid{{
+				Seat: "seat1",
+				Bid:  []Bid{{ID: "bid1", ImpID: staticImpID, Price: 2.5, Adomain: []string{"ads.example.com"}, Cat: []string{"IAB1"}, Attr: []CreativeAttribute{AttrAudioAutoPlay}}},
+			}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(req *Request, resp *BidResponse)
+		wantPath string
+		want     LossReason
+	}{
+		{
+			name: "Valid",
+		},
+		{
+			name:     "Auction ID Mismatch",
+			mutate:   func(_ *Request, resp *BidResponse) { resp.ID = "foo" },
+			wantPath: "id",
+			want:     LossInvalidAuctionID,
+		},
+		{
+			name:     "Currency Not Allowed",
+			mutate:   func(_ *Request, resp *BidResponse) { resp.Cur = "EUR" },
+			wantPath: "cur",
+			want:     LossInvalidBidResponse,
+		},
+		{
+			name:   "Currency Allowed",
+			mutate: func(req *Request, resp *BidResponse) { req.Cur, resp.Cur = []string{"USD", "EUR"}, "EUR" },
+		},
+		{
+			name:     "Unknown Imp",
+			mutate:   func(_ *Request, resp *BidResponse) { resp.SeatBid[0].Bid[0].ImpID = "foo" },
+			wantPath: "seatbid[0].bid[0].impid",
+			want:     LossInvalidBidResponse,
+		},
+		{
+			name:     "Below Floor",
+			mutate:   func(_ *Request, resp *BidResponse) { resp.SeatBid[0].Bid[0].Price = 1 },
+			wantPath: "seatbid[0].bid[0].price",
+			want:     LossBelowAuctionFloor,
+		},
+		{
+			name: "Below Deal Floor",
+			mutate: func(req *Request, resp *BidResponse) {
+				req.Imp[0].PMP = &PMP{Deals: []Deal{{ID: "deal1", BidFloor: 5}}}
+				resp.SeatBid[0].Bid[0].DealID = "deal1"
+			},
+			wantPath: "seatbid[0].bid[0].price",
+			want:     LossBelowDealFloor,
+		},
+		{
+			name: "Unknown Deal",
+			mutate: func(req *Request, resp *BidResponse) {
+				req.Imp[0].PMP = &PMP{Deals: []Deal{{ID: "deal1"}}}
+				resp.SeatBid[0].Bid[0].DealID = "deal2"
+			},
+			wantPath: "seatbid[0].bid[0].dealid",
+			want:     LossInvalidDealID,
+		},
+		{
+			name: "Deal Seat Not Allowed",
+			mutate: func(req *Request, resp *BidResponse) {
+				req.Imp[0].PMP = &PMP{Deals: []Deal{{ID: "deal1", WSeat: []string{"seat2"}}}}
+				resp.SeatBid[0].Bid[0].DealID = "deal1"
+			},
+			wantPath: "seatbid[0].bid[0].dealid",
+			want:     LossBuyerSeatBlocked,
+		},
+		{
+			name:     "Blocked Advertiser",
+			mutate:   func(req *Request, _ *BidResponse) { req.BAdv = []string{"Example.com"} },
+			wantPath: "seatbid[0].bid[0].adomain[0]",
+			want:     LossAdvertiserExclusions,
+		},
+		{
+			name:   "Similar Advertiser",
+			mutate: func(req *Request, _ *BidResponse) { req.BAdv = []string{"ample.com"} },
+		},
+		{
+			name:     "Blocked Category",
+			mutate:   func(req *Request, _ *BidResponse) { req.Bcat = []string{"IAB2", "IAB1"} },
+			wantPath: "seatbid[0].bid[0].cat[0]",
+			want:     LossCategoryExclusions,
+		},
+		{
+			name:     "Blocked Attribute",
+			mutate:   func(req *Request, _ *BidResponse) { req.Imp[0].Banner.BAttr = []CreativeAttribute{1, 2} },
+			wantPath: "seatbid[0].bid[0].attr[0]",
+			want:     LossCreativeAttributeExclusions,
+		},
+		{
+			name:     "Seat Not Allowed",
+			mutate:   func(req *Request, _ *BidResponse) { req.WSeat = []string{"seat2"} },
+			wantPath: "seatbid[0].seat",
+			want:     LossBuyerSeatBlocked,
+		},
+		{
+			name:     "Seat Blocked",
+			mutate:   func(req *Request, _ *BidResponse) { req.BSeat = []string{"seat1"} },
+			wantPath: "seatbid[0].seat",
+			want:     LossBuyerSeatBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := loadRequest(t, "./test_data/static_bid_request.json")
+			resp := newResponse()
+			if tt.mutate != nil {
+				tt.mutate(req, resp)
+			}
+
+			err := ValidateResponse(req, resp)
+			if tt.wantPath == "" {
+				if err != nil {
+					t.Fatalf("ValidateResponse() = %v, want nil", err)
+				}
+				return
+			}
+
+			rejections, ok := err.(BidRejections)
+			if !ok || len(rejections) != 1 {
+				t.Fatalf("ValidateResponse() = %v, want a single rejection", err)
+			}
+			got := rejections[0]
+			if got.Path != tt.wantPath || got.Reason != tt.want {
+				t.Errorf("got %s %v, want %s %v", got.Path, got.Reason, tt.wantPath, tt.want)
+			}
+			if got.BidID != "bid1" || got.Seat != "seat1" {
+				t.Errorf("got bid %q seat %q, want bid1 seat1", got.BidID, got.Seat)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package twofive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/timehop/ortb-twofive/twosix"
+)
+
+// ClientErrorKind classifies why a Client couldn't get a bid response
+type ClientErrorKind int
+
+// The kinds of ClientError
+const (
+	ClientTimeout   ClientErrorKind = iota + 1 // the deadline passed before the response was read
+	ClientTransport                            // the request couldn't be sent or the response read
+	ClientBadStatus                            // the bidder answered with neither a 200 nor a 204
+	ClientBadJSON                              // the body isn't a bid response
+	ClientEmpty                                // the bidder answered a 200 with an empty body
+)
+
+func (k ClientErrorKind) String() string {
+	switch k {
+	case ClientTimeout:
+		return "timeout"
+	case ClientTransport:
+		return "transport"
+	case ClientBadStatus:
+		return "bad status"
+	case ClientBadJSON:
+		return "bad json"
+	case ClientEmpty:
+		return "empty"
+	}
+	return "ClientErrorKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// ClientError is the error returned by a Client, StatusCode is set when the bidder answered
+type ClientError struct {
+	Kind       ClientErrorKind
+	StatusCode int
+	Err        error
+}
+
+func (e *ClientError) Error() string {
+	s := "twofive: bidder " + e.Kind.String()
+	if e.StatusCode != 0 {
+		s += " " + strconv.Itoa(e.StatusCode)
+	}
+	if e.Err != nil {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+func (e *ClientError) Unwrap() error { return e.Err }
+
+// Client sends bid requests to a single bidder endpoint over HTTP, with the OpenRTB version header. The request
+// must be answered within its tmax, when set, on top of any deadline of the context. A 204 is a no bid, gzipped
+// responses are always accepted. The zero Client isn't usable, Endpoint is required
+type Client struct {
+	Endpoint   string
+	HTTPClient *http.Client // http.DefaultClient if nil
+	Gzip       bool         // compress the request body
+	Header     http.Header  // sent with every request, in addition to the OpenRTB headers
+
+	// V26 sends the requests as OpenRTB 2.6, converted by ToV26, with the 2.6 version header. The responses are
+	// the same in either version
+	V26 bool
+
+	// Converted is called with the losses of converting a request to 2.6 that had any, before the request is sent
+	Converted func(req *Request, losses []Loss)
+}
+
+// Bid sends the request and returns the bidder's response. A no bid, however it's signalled, is returned as a
+// response with no bids, see IsNoBid. Errors are always of type *ClientError
+func (c *Client) Bid(ctx context.Context, req *Request) (*BidResponse, error) {
+	if req.Tmax > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.Tmax)*time.Millisecond)
+		defer cancel()
+	}
+
+	body, err := c.encode(req)
+	if err != nil {
+		return nil, &ClientError{Kind: ClientTransport, Err: err}
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint, body)
+	if err != nil {
+		return nil, &ClientError{Kind: ClientTransport, Err: err}
+	}
+	for k, v := range c.Header {
+		httpReq.Header[k] = v
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.V26 {
+		httpReq.Header.Set(twosix.Header, twosix.Version)
+	} else {
+		httpReq.Header.Set(Header, Version)
+	}
+	httpReq.Header.Set("Accept-Encoding", EncodingValue)
+	if c.Gzip {
+		httpReq.Header.Set(EncodingHeader, EncodingValue)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, classify(ctx, 0, err)
+	}
+	defer httpResp.Body.Close()
+
+	switch httpResp.StatusCode {
+	case StatusNoBid:
+		io.Copy(io.Discard, httpResp.Body)
+		resp := NoBid(req.ID, NoBidUnknownError)
+		return &resp, nil
+	case http.StatusOK:
+	default:
+		io.Copy(io.Discard, httpResp.Body)
+		return nil, &ClientError{Kind: ClientBadStatus, StatusCode: httpResp.StatusCode}
+	}
+
+	b, err := readBody(httpResp.Body, httpResp.Header.Get(EncodingHeader))
+	if err != nil {
+		return nil, classify(ctx, httpResp.StatusCode, err)
+	}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, &ClientError{Kind: ClientEmpty, StatusCode: httpResp.StatusCode}
+	}
+
+	var resp BidResponse
+	if err := resp.UnmarshalJSON(b); err != nil {
+		return nil, &ClientError{Kind: ClientBadJSON, StatusCode: httpResp.StatusCode, Err: err}
+	}
+	return &resp, nil
+}
+
+func (c *Client) encode(req *Request) (io.Reader, error) {
+	var b []byte
+	var err error
+	if c.V26 {
+		v, losses := ToV26(req)
+		if len(losses) > 0 && c.Converted != nil {
+			c.Converted(req, losses)
+		}
+		b, err = json.Marshal(v)
+	} else {
+		b, err = req.MarshalJSON()
+	}
+	if err != nil || !c.Gzip {
+		return bytes.NewReader(b), err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(b); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+// readBody reads a body, decompressing it when the content encoding is gzip
+func readBody(body io.Reader, encoding string) ([]byte, error) {
+	if !strings.EqualFold(encoding, EncodingValue) {
+		return io.ReadAll(body)
+	}
+
+	zr, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// classify wraps an error of sending a request or reading its response, as a timeout when the deadline passed
+func classify(ctx context.Context, status int, err error) *ClientError {
+	var netErr net.Error
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) || errors.Is(err, context.DeadlineExceeded) ||
+		(errors.As(err, &netErr) && netErr.Timeout()) {
+		return &ClientError{Kind: ClientTimeout, StatusCode: status, Err: err}
+	}
+	return &ClientError{Kind: ClientTransport, StatusCode: status, Err: err}
+}
@@ -0,0 +1,154 @@
+package twofive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/timehop/ortb-twofive/twosix"
+)
+
+func TestClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		gzip    bool
+		tmax    int
+		handler http.HandlerFunc
+		noBid   bool
+		want    ClientErrorKind
+	}{
+		{
+			name: "Bid",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				io.WriteString(w, `{"id":"1","seatbid":[{"bid":[{"id":"1","impid":"1","price":1}]}]}`)
+			},
+		},
+		{
+			name: "Gzip",
+			gzip: true,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(EncodingHeader, EncodingValue)
+				zw := gzip.NewWriter(w)
+				io.WriteString(zw, `{"id":"1","seatbid":[{"bid":[{"id":"1","impid":"1","price":1}]}]}`)
+				zw.Close()
+			},
+		},
+		{
+			name:    "No Content",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusNoContent) },
+			noBid:   true,
+		},
+		{
+			name:    "Empty Object",
+			handler: func(w http.ResponseWriter, r *http.Request) { io.WriteString(w, `{}`) },
+			noBid:   true,
+		},
+		{
+			name:    "Bad Status",
+			handler: func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusBadGateway) },
+			want:    ClientBadStatus,
+		},
+		{
+			name:    "Bad JSON",
+			handler: func(w http.ResponseWriter, r *http.Request) { io.WriteString(w, `<html></html>`) },
+			want:    ClientBadJSON,
+		},
+		{
+			name:    "Empty",
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+			want:    ClientEmpty,
+		},
+		{
+			name: "Timeout",
+			tmax: 20,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-time.After(time.Second):
+				case <-r.Context().Done():
+				}
+			},
+			want: ClientTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if v := r.Header.Get(Header); v != Version {
+					t.Errorf("%s = %q, want %q", Header, v, Version)
+				}
+
+				body := io.Reader(r.Body)
+				if tt.gzip {
+					if r.Header.Get(EncodingHeader) != EncodingValue {
+						t.Errorf("%s = %q, want %q", EncodingHeader, r.Header.Get(EncodingHeader), EncodingValue)
+					}
+					zr, err := gzip.NewReader(r.Body)
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					body = zr
+				}
+				var req Request
+				if err := json.NewDecoder(body).Decode(&req); err != nil || req.ID != "1" {
+					t.Errorf("bidder received %+v, %v", req, err)
+				}
+				tt.handler(w, r)
+			}))
+			defer srv.Close()
+
+			c := &Client{Endpoint: srv.URL, HTTPClient: srv.Client(), Gzip: tt.gzip}
+			resp, err := c.Bid(context.Background(), &Request{ID: "1", Tmax: tt.tmax})
+			if tt.want != 0 {
+				var cerr *ClientError
+				if !errors.As(err, &cerr) || cerr.Kind != tt.want {
+					t.Fatalf("Bid() = %v, want a %s error", err, tt.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.IsNoBid() != tt.noBid {
+				t.Errorf("Bid() = %+v, IsNoBid() want %v", resp, tt.noBid)
+			}
+		})
+	}
+}
+
+func TestClientV26(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get(twosix.Header); v != twosix.Version {
+			t.Errorf("%s = %q, want %q", twosix.Header, v, twosix.Version)
+		}
+		var req twosix.BidRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.ID != "1" || req.Regs == nil || req.Regs.GDPR == nil {
+			t.Errorf("bidder received %+v, %v", req, err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	var losses []Loss
+	c := &Client{
+		Endpoint:   srv.URL,
+		HTTPClient: srv.Client(),
+		V26:        true,
+		Converted:  func(req *Request, l []Loss) { losses = l },
+	}
+	req := &Request{ID: "1", Regs: Regs{Ext: &RegsExt{GDPR: 1}}, User: User{Age: 30}}
+	if _, err := c.Bid(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if want := []Loss{{Path: "user.age", Reason: "no 2.6 field"}}; !reflect.DeepEqual(losses, want) {
+		t.Errorf("Converted() losses = %v, want %v", losses, want)
+	}
+}
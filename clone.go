@@ -0,0 +1,126 @@
+package twofive
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// The Clone methods of the objects are generated from their declarations into clone_gen.go
+//go:generate go run ./internal/gen
+
+// clone returns a copy of the extensions sharing no memory with them. Raw values are copied, registered values
+// are copied field by field, as they're opaque to the package
+func (e Extensions) clone() Extensions {
+	var c Extensions
+	if e.raw != nil {
+		c.raw = make(map[string]json.RawMessage, len(e.raw))
+		for k, v := range e.raw {
+			c.raw[k] = append(v[:0:0], v...)
+		}
+	}
+	if e.typed != nil {
+		c.typed = make(map[string]interface{}, len(e.typed))
+		for k, v := range e.typed {
+			c.typed[k] = cloneTyped(v)
+		}
+	}
+	if e.order != nil {
+		c.order = append([]string(nil), e.order...)
+	}
+	return c
+}
+
+// cloneTyped copies a registered ext value, a pointer to the registered type
+func cloneTyped(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return deepCopy(reflect.ValueOf(v), make(map[uintptr]reflect.Value)).Interface()
+}
+
+var extensionsType = reflect.TypeOf(Extensions{})
+
+// deepCopy returns a copy of v sharing no pointer, slice or map with it, but through its unexported fields,
+// which are copied as they are. copied are the copies of the pointers met so far, so a value pointed to twice
+// is copied once, and a cycle ends
+func deepCopy(v reflect.Value, copied map[uintptr]reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		if c, ok := copied[v.Pointer()]; ok && c.Type() == v.Type() {
+			return c
+		}
+		c := reflect.New(v.Type().Elem())
+		copied[v.Pointer()] = c
+		c.Elem().Set(deepCopy(v.Elem(), copied))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem(), copied))
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), copied))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i), copied))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for it := v.MapRange(); it.Next(); {
+			c.SetMapIndex(it.Key(), deepCopy(it.Value(), copied))
+		}
+		return c
+	case reflect.Struct:
+		if v.Type() == extensionsType && v.CanInterface() {
+			return reflect.ValueOf(v.Interface().(Extensions).clone())
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i), copied))
+			}
+		}
+		return c
+	}
+	return v
+}
+
+// cloneJSONValue copies an arbitrary value decoded from JSON, the maps and slices of which would otherwise be
+// shared. Values of other types are returned as they are
+func cloneJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		c := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			c[k] = cloneJSONValue(e)
+		}
+		return c
+	case []interface{}:
+		c := make([]interface{}, len(v))
+		for i, e := range v {
+			c[i] = cloneJSONValue(e)
+		}
+		return c
+	case json.RawMessage:
+		return append(v[:0:0], v...)
+	}
+	return v
+}
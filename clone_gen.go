@@ -0,0 +1,1355 @@
+// Code generated by internal/gen; DO NOT EDIT.
+
+package twofive
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *App) Clone() *App {
+	if x == nil {
+		return nil
+	}
+	c := new(App)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *App) cloneTo(c *App) {
+	*c = *x
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.SectionCat = append(x.SectionCat[:0:0], x.SectionCat...)
+	c.PageCat = append(x.PageCat[:0:0], x.PageCat...)
+	x.Publisher.cloneTo(&c.Publisher)
+	c.Content = x.Content.Clone()
+	c.Keywords = append(x.Keywords[:0:0], x.Keywords...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *AppExt) Clone() *AppExt {
+	if x == nil {
+		return nil
+	}
+	c := new(AppExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *AppExt) cloneTo(c *AppExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Audio) Clone() *Audio {
+	if x == nil {
+		return nil
+	}
+	c := new(Audio)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Audio) cloneTo(c *Audio) {
+	*c = *x
+	c.Mimes = append(x.Mimes[:0:0], x.Mimes...)
+	c.Protocols = append(x.Protocols[:0:0], x.Protocols...)
+	c.Battr = append(x.Battr[:0:0], x.Battr...)
+	c.Delivery = append(x.Delivery[:0:0], x.Delivery...)
+	if x.CompanionAd != nil {
+		c.CompanionAd = make([]Banner, len(x.CompanionAd))
+		for i := range x.CompanionAd {
+			x.CompanionAd[i].cloneTo(&c.CompanionAd[i])
+		}
+	}
+	c.API = append(x.API[:0:0], x.API...)
+	c.CompanionType = append(x.CompanionType[:0:0], x.CompanionType...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *AudioExt) Clone() *AudioExt {
+	if x == nil {
+		return nil
+	}
+	c := new(AudioExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *AudioExt) cloneTo(c *AudioExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Banner) Clone() *Banner {
+	if x == nil {
+		return nil
+	}
+	c := new(Banner)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Banner) cloneTo(c *Banner) {
+	*c = *x
+	if x.BidFloor != nil {
+		v := *x.BidFloor
+		c.BidFloor = &v
+	}
+	c.BAttr = append(x.BAttr[:0:0], x.BAttr...)
+	if x.Format != nil {
+		c.Format = make([]Format, len(x.Format))
+		for i := range x.Format {
+			x.Format[i].cloneTo(&c.Format[i])
+		}
+	}
+	c.API = append(x.API[:0:0], x.API...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *BannerExt) Clone() *BannerExt {
+	if x == nil {
+		return nil
+	}
+	c := new(BannerExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *BannerExt) cloneTo(c *BannerExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Bid) Clone() *Bid {
+	if x == nil {
+		return nil
+	}
+	c := new(Bid)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Bid) cloneTo(c *Bid) {
+	*c = *x
+	c.Adomain = append(x.Adomain[:0:0], x.Adomain...)
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.Attr = append(x.Attr[:0:0], x.Attr...)
+	x.Ext.cloneTo(&c.Ext)
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *BidExt) Clone() *BidExt {
+	if x == nil {
+		return nil
+	}
+	c := new(BidExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *BidExt) cloneTo(c *BidExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *BidResponse) Clone() *BidResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(BidResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *BidResponse) cloneTo(c *BidResponse) {
+	*c = *x
+	if x.SeatBid != nil {
+		c.SeatBid = make([]Seatbid, len(x.SeatBid))
+		for i := range x.SeatBid {
+			x.SeatBid[i].cloneTo(&c.SeatBid[i])
+		}
+	}
+	x.Ext.cloneTo(&c.Ext)
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *BidResponseExt) Clone() *BidResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(BidResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *BidResponseExt) cloneTo(c *BidResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Content) Clone() *Content {
+	if x == nil {
+		return nil
+	}
+	c := new(Content)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Content) cloneTo(c *Content) {
+	*c = *x
+	c.Producer = x.Producer.Clone()
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.Keywords = append(x.Keywords[:0:0], x.Keywords...)
+	if x.Data != nil {
+		c.Data = make([]Data, len(x.Data))
+		for i := range x.Data {
+			x.Data[i].cloneTo(&c.Data[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *ContentExt) Clone() *ContentExt {
+	if x == nil {
+		return nil
+	}
+	c := new(ContentExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *ContentExt) cloneTo(c *ContentExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Data) Clone() *Data {
+	if x == nil {
+		return nil
+	}
+	c := new(Data)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Data) cloneTo(c *Data) {
+	*c = *x
+	if x.Segment != nil {
+		c.Segment = make([]Segment, len(x.Segment))
+		for i := range x.Segment {
+			x.Segment[i].cloneTo(&c.Segment[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *DataExt) Clone() *DataExt {
+	if x == nil {
+		return nil
+	}
+	c := new(DataExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *DataExt) cloneTo(c *DataExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Deal) Clone() *Deal {
+	if x == nil {
+		return nil
+	}
+	c := new(Deal)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Deal) cloneTo(c *Deal) {
+	*c = *x
+	c.WSeat = append(x.WSeat[:0:0], x.WSeat...)
+	c.WAdomain = append(x.WAdomain[:0:0], x.WAdomain...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *DealExt) Clone() *DealExt {
+	if x == nil {
+		return nil
+	}
+	c := new(DealExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *DealExt) cloneTo(c *DealExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Device) Clone() *Device {
+	if x == nil {
+		return nil
+	}
+	c := new(Device)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Device) cloneTo(c *Device) {
+	*c = *x
+	c.Geo = x.Geo.Clone()
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *DeviceExt) Clone() *DeviceExt {
+	if x == nil {
+		return nil
+	}
+	c := new(DeviceExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *DeviceExt) cloneTo(c *DeviceExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Format) Clone() *Format {
+	if x == nil {
+		return nil
+	}
+	c := new(Format)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Format) cloneTo(c *Format) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *FormatExt) Clone() *FormatExt {
+	if x == nil {
+		return nil
+	}
+	c := new(FormatExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *FormatExt) cloneTo(c *FormatExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Geo) Clone() *Geo {
+	if x == nil {
+		return nil
+	}
+	c := new(Geo)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Geo) cloneTo(c *Geo) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *GeoExt) Clone() *GeoExt {
+	if x == nil {
+		return nil
+	}
+	c := new(GeoExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *GeoExt) cloneTo(c *GeoExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Imp) Clone() *Imp {
+	if x == nil {
+		return nil
+	}
+	c := new(Imp)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Imp) cloneTo(c *Imp) {
+	*c = *x
+	if x.Metric != nil {
+		c.Metric = make([]Metric, len(x.Metric))
+		for i := range x.Metric {
+			x.Metric[i].cloneTo(&c.Metric[i])
+		}
+	}
+	c.Banner = x.Banner.Clone()
+	c.Video = x.Video.Clone()
+	c.Audio = x.Audio.Clone()
+	c.Native = x.Native.Clone()
+	c.PMP = x.PMP.Clone()
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *ImpExt) Clone() *ImpExt {
+	if x == nil {
+		return nil
+	}
+	c := new(ImpExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *ImpExt) cloneTo(c *ImpExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Metric) Clone() *Metric {
+	if x == nil {
+		return nil
+	}
+	c := new(Metric)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Metric) cloneTo(c *Metric) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *MetricExt) Clone() *MetricExt {
+	if x == nil {
+		return nil
+	}
+	c := new(MetricExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *MetricExt) cloneTo(c *MetricExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Native) Clone() *Native {
+	if x == nil {
+		return nil
+	}
+	c := new(Native)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Native) cloneTo(c *Native) {
+	*c = *x
+	c.API = append(x.API[:0:0], x.API...)
+	c.Battr = append(x.Battr[:0:0], x.Battr...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeAsset) Clone() *NativeAsset {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeAsset)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeAsset) cloneTo(c *NativeAsset) {
+	*c = *x
+	c.Title = x.Title.Clone()
+	c.Img = x.Img.Clone()
+	c.Video = x.Video.Clone()
+	c.Data = x.Data.Clone()
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeAssetExt) Clone() *NativeAssetExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeAssetExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeAssetExt) cloneTo(c *NativeAssetExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeData) Clone() *NativeData {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeData)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeData) cloneTo(c *NativeData) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeDataExt) Clone() *NativeDataExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeDataExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeDataExt) cloneTo(c *NativeDataExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeDataResponse) Clone() *NativeDataResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeDataResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeDataResponse) cloneTo(c *NativeDataResponse) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeDataResponseExt) Clone() *NativeDataResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeDataResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeDataResponseExt) cloneTo(c *NativeDataResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeEventTracker) Clone() *NativeEventTracker {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeEventTracker)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeEventTracker) cloneTo(c *NativeEventTracker) {
+	*c = *x
+	c.Methods = append(x.Methods[:0:0], x.Methods...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeEventTrackerExt) Clone() *NativeEventTrackerExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeEventTrackerExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeEventTrackerExt) cloneTo(c *NativeEventTrackerExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeEventTrackerResponse) Clone() *NativeEventTrackerResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeEventTrackerResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeEventTrackerResponse) cloneTo(c *NativeEventTrackerResponse) {
+	*c = *x
+	c.CustomData = append(x.CustomData[:0:0], x.CustomData...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeEventTrackerResponseExt) Clone() *NativeEventTrackerResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeEventTrackerResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeEventTrackerResponseExt) cloneTo(c *NativeEventTrackerResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeExt) Clone() *NativeExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeExt) cloneTo(c *NativeExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeImage) Clone() *NativeImage {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeImage)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeImage) cloneTo(c *NativeImage) {
+	*c = *x
+	c.Mimes = append(x.Mimes[:0:0], x.Mimes...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeImageExt) Clone() *NativeImageExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeImageExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeImageExt) cloneTo(c *NativeImageExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeImageResponse) Clone() *NativeImageResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeImageResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeImageResponse) cloneTo(c *NativeImageResponse) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeImageResponseExt) Clone() *NativeImageResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeImageResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeImageResponseExt) cloneTo(c *NativeImageResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeLink) Clone() *NativeLink {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeLink)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeLink) cloneTo(c *NativeLink) {
+	*c = *x
+	c.ClickTrackers = append(x.ClickTrackers[:0:0], x.ClickTrackers...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeLinkExt) Clone() *NativeLinkExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeLinkExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeLinkExt) cloneTo(c *NativeLinkExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeRequest) Clone() *NativeRequest {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeRequest)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeRequest) cloneTo(c *NativeRequest) {
+	*c = *x
+	if x.Assets != nil {
+		c.Assets = make([]NativeAsset, len(x.Assets))
+		for i := range x.Assets {
+			x.Assets[i].cloneTo(&c.Assets[i])
+		}
+	}
+	if x.EventTrackers != nil {
+		c.EventTrackers = make([]NativeEventTracker, len(x.EventTrackers))
+		for i := range x.EventTrackers {
+			x.EventTrackers[i].cloneTo(&c.EventTrackers[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeRequestExt) Clone() *NativeRequestExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeRequestExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeRequestExt) cloneTo(c *NativeRequestExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeResponse) Clone() *NativeResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeResponse) cloneTo(c *NativeResponse) {
+	*c = *x
+	if x.Assets != nil {
+		c.Assets = make([]NativeResponseAsset, len(x.Assets))
+		for i := range x.Assets {
+			x.Assets[i].cloneTo(&c.Assets[i])
+		}
+	}
+	x.Link.cloneTo(&c.Link)
+	c.ImpTrackers = append(x.ImpTrackers[:0:0], x.ImpTrackers...)
+	if x.EventTrackers != nil {
+		c.EventTrackers = make([]NativeEventTrackerResponse, len(x.EventTrackers))
+		for i := range x.EventTrackers {
+			x.EventTrackers[i].cloneTo(&c.EventTrackers[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeResponseAsset) Clone() *NativeResponseAsset {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeResponseAsset)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeResponseAsset) cloneTo(c *NativeResponseAsset) {
+	*c = *x
+	c.Title = x.Title.Clone()
+	c.Img = x.Img.Clone()
+	c.Video = x.Video.Clone()
+	c.Data = x.Data.Clone()
+	c.Link = x.Link.Clone()
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeResponseAssetExt) Clone() *NativeResponseAssetExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeResponseAssetExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeResponseAssetExt) cloneTo(c *NativeResponseAssetExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeResponseExt) Clone() *NativeResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeResponseExt) cloneTo(c *NativeResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeTitle) Clone() *NativeTitle {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeTitle)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeTitle) cloneTo(c *NativeTitle) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeTitleExt) Clone() *NativeTitleExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeTitleExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeTitleExt) cloneTo(c *NativeTitleExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeTitleResponse) Clone() *NativeTitleResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeTitleResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeTitleResponse) cloneTo(c *NativeTitleResponse) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeTitleResponseExt) Clone() *NativeTitleResponseExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeTitleResponseExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeTitleResponseExt) cloneTo(c *NativeTitleResponseExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeVideo) Clone() *NativeVideo {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeVideo)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeVideo) cloneTo(c *NativeVideo) {
+	*c = *x
+	c.Mimes = append(x.Mimes[:0:0], x.Mimes...)
+	c.Protocols = append(x.Protocols[:0:0], x.Protocols...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeVideoExt) Clone() *NativeVideoExt {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeVideoExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeVideoExt) cloneTo(c *NativeVideoExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *NativeVideoResponse) Clone() *NativeVideoResponse {
+	if x == nil {
+		return nil
+	}
+	c := new(NativeVideoResponse)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *NativeVideoResponse) cloneTo(c *NativeVideoResponse) {
+	*c = *x
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *PMP) Clone() *PMP {
+	if x == nil {
+		return nil
+	}
+	c := new(PMP)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *PMP) cloneTo(c *PMP) {
+	*c = *x
+	if x.Deals != nil {
+		c.Deals = make([]Deal, len(x.Deals))
+		for i := range x.Deals {
+			x.Deals[i].cloneTo(&c.Deals[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *PMPExt) Clone() *PMPExt {
+	if x == nil {
+		return nil
+	}
+	c := new(PMPExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *PMPExt) cloneTo(c *PMPExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Producer) Clone() *Producer {
+	if x == nil {
+		return nil
+	}
+	c := new(Producer)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Producer) cloneTo(c *Producer) {
+	*c = *x
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *ProducerExt) Clone() *ProducerExt {
+	if x == nil {
+		return nil
+	}
+	c := new(ProducerExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *ProducerExt) cloneTo(c *ProducerExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Publisher) Clone() *Publisher {
+	if x == nil {
+		return nil
+	}
+	c := new(Publisher)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Publisher) cloneTo(c *Publisher) {
+	*c = *x
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *PublisherExt) Clone() *PublisherExt {
+	if x == nil {
+		return nil
+	}
+	c := new(PublisherExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *PublisherExt) cloneTo(c *PublisherExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Regs) Clone() *Regs {
+	if x == nil {
+		return nil
+	}
+	c := new(Regs)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Regs) cloneTo(c *Regs) {
+	*c = *x
+	c.GPPSID = append(x.GPPSID[:0:0], x.GPPSID...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *RegsExt) Clone() *RegsExt {
+	if x == nil {
+		return nil
+	}
+	c := new(RegsExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *RegsExt) cloneTo(c *RegsExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Request) Clone() *Request {
+	if x == nil {
+		return nil
+	}
+	c := new(Request)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Request) cloneTo(c *Request) {
+	*c = *x
+	if x.Imp != nil {
+		c.Imp = make([]Imp, len(x.Imp))
+		for i := range x.Imp {
+			x.Imp[i].cloneTo(&c.Imp[i])
+		}
+	}
+	c.App = x.App.Clone()
+	c.Site = x.Site.Clone()
+	x.Device.cloneTo(&c.Device)
+	x.Format.cloneTo(&c.Format)
+	x.User.cloneTo(&c.User)
+	c.WSeat = append(x.WSeat[:0:0], x.WSeat...)
+	c.BSeat = append(x.BSeat[:0:0], x.BSeat...)
+	c.Cur = append(x.Cur[:0:0], x.Cur...)
+	c.Wlang = append(x.Wlang[:0:0], x.Wlang...)
+	c.Bcat = append(x.Bcat[:0:0], x.Bcat...)
+	c.BAdv = append(x.BAdv[:0:0], x.BAdv...)
+	c.BApp = append(x.BApp[:0:0], x.BApp...)
+	c.Source = x.Source.Clone()
+	x.Regs.cloneTo(&c.Regs)
+	x.Ext.cloneTo(&c.Ext)
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *RequestExt) Clone() *RequestExt {
+	if x == nil {
+		return nil
+	}
+	c := new(RequestExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *RequestExt) cloneTo(c *RequestExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Seatbid) Clone() *Seatbid {
+	if x == nil {
+		return nil
+	}
+	c := new(Seatbid)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Seatbid) cloneTo(c *Seatbid) {
+	*c = *x
+	if x.Bid != nil {
+		c.Bid = make([]Bid, len(x.Bid))
+		for i := range x.Bid {
+			x.Bid[i].cloneTo(&c.Bid[i])
+		}
+	}
+	x.Ext.cloneTo(&c.Ext)
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *SeatbidExt) Clone() *SeatbidExt {
+	if x == nil {
+		return nil
+	}
+	c := new(SeatbidExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *SeatbidExt) cloneTo(c *SeatbidExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Segment) Clone() *Segment {
+	if x == nil {
+		return nil
+	}
+	c := new(Segment)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Segment) cloneTo(c *Segment) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *SegmentExt) Clone() *SegmentExt {
+	if x == nil {
+		return nil
+	}
+	c := new(SegmentExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *SegmentExt) cloneTo(c *SegmentExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Site) Clone() *Site {
+	if x == nil {
+		return nil
+	}
+	c := new(Site)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Site) cloneTo(c *Site) {
+	*c = *x
+	c.Cat = append(x.Cat[:0:0], x.Cat...)
+	c.SectionCat = append(x.SectionCat[:0:0], x.SectionCat...)
+	c.PageCat = append(x.PageCat[:0:0], x.PageCat...)
+	x.Publisher.cloneTo(&c.Publisher)
+	c.Content = x.Content.Clone()
+	c.Keywords = append(x.Keywords[:0:0], x.Keywords...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *SiteExt) Clone() *SiteExt {
+	if x == nil {
+		return nil
+	}
+	c := new(SiteExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *SiteExt) cloneTo(c *SiteExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Source) Clone() *Source {
+	if x == nil {
+		return nil
+	}
+	c := new(Source)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Source) cloneTo(c *Source) {
+	*c = *x
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *SourceExt) Clone() *SourceExt {
+	if x == nil {
+		return nil
+	}
+	c := new(SourceExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *SourceExt) cloneTo(c *SourceExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *User) Clone() *User {
+	if x == nil {
+		return nil
+	}
+	c := new(User)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *User) cloneTo(c *User) {
+	*c = *x
+	c.Geo = x.Geo.Clone()
+	if x.Data != nil {
+		c.Data = make([]Data, len(x.Data))
+		for i := range x.Data {
+			x.Data[i].cloneTo(&c.Data[i])
+		}
+	}
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *UserExt) Clone() *UserExt {
+	if x == nil {
+		return nil
+	}
+	c := new(UserExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *UserExt) cloneTo(c *UserExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *Video) Clone() *Video {
+	if x == nil {
+		return nil
+	}
+	c := new(Video)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *Video) cloneTo(c *Video) {
+	*c = *x
+	if x.BidFloor != nil {
+		v := *x.BidFloor
+		c.BidFloor = &v
+	}
+	c.Mimes = append(x.Mimes[:0:0], x.Mimes...)
+	c.Protocols = append(x.Protocols[:0:0], x.Protocols...)
+	c.Playbackmethod = append(x.Playbackmethod[:0:0], x.Playbackmethod...)
+	c.Delivery = append(x.Delivery[:0:0], x.Delivery...)
+	c.API = append(x.API[:0:0], x.API...)
+	c.Ext = x.Ext.Clone()
+}
+
+// Clone returns a deep copy of x, sharing no memory with it
+func (x *VideoExt) Clone() *VideoExt {
+	if x == nil {
+		return nil
+	}
+	c := new(VideoExt)
+	x.cloneTo(c)
+	return c
+}
+
+func (x *VideoExt) cloneTo(c *VideoExt) {
+	*c = *x
+	c.Extensions = x.Extensions.clone()
+}
@@ -0,0 +1,217 @@
+package twofive
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type cloneTestExt struct {
+	IDs []string `json:"ids"`
+}
+
+// fill sets every field reachable from v, slices to two elements, so a clone that misses one is caught
+func fill(v reflect.Value, depth int) {
+	if depth > 10 {
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString("s")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(map[string]interface{}{"k": []interface{}{"v", 1.0}}))
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem(), depth+1)
+	case reflect.Slice:
+		if v.Type() == reflect.TypeOf(json.RawMessage(nil)) {
+			v.SetBytes([]byte(`{"k":1}`))
+			return
+		}
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i), depth+1)
+		}
+	case reflect.Struct:
+		if v.Type() == extensionsType {
+			e := v.Addr().Interface().(*Extensions)
+			e.SetExt("raw", map[string]int{"k": 1})
+			e.typed = map[string]interface{}{"typed": &cloneTestExt{IDs: []string{"a", "b"}}}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				fill(v.Field(i), depth+1)
+			}
+		}
+	}
+}
+
+// shared walks a and b in step, returning the path of the first pointer, slice or map they share
+func shared(a, b reflect.Value, path string) string {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return ""
+		}
+		if a.Pointer() == b.Pointer() {
+			return path
+		}
+		return shared(a.Elem(), b.Elem(), path)
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return ""
+		}
+		return shared(a.Elem(), b.Elem(), path)
+	case reflect.Slice:
+		if a.Len() == 0 || b.Len() == 0 {
+			return ""
+		}
+		if a.Pointer() == b.Pointer() {
+			return path
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			if p := shared(a.Index(i), b.Index(i), path+"[]"); p != "" {
+				return p
+			}
+		}
+	case reflect.Map:
+		if a.Len() == 0 || b.Len() == 0 {
+			return ""
+		}
+		if a.Pointer() == b.Pointer() {
+			return path
+		}
+		for _, k := range a.MapKeys() {
+			if bv := b.MapIndex(k); bv.IsValid() {
+				if p := shared(a.MapIndex(k), bv, path+"."+k.String()); p != "" {
+					return p
+				}
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if p := shared(a.Field(i), b.Field(i), path+"."+a.Type().Field(i).Name); p != "" {
+				return p
+			}
+		}
+	}
+	return ""
+}
+
+// fillAndClone returns a filled T and its clone
+func fillAndClone[T any, P interface {
+	*T
+	Clone() *T
+}]() (interface{}, interface{}) {
+	x := P(new(T))
+	fill(reflect.ValueOf(x).Elem(), 0)
+	return x, x.Clone()
+}
+
+func TestClone(t *testing.T) {
+	tests := []struct {
+		name  string
+		clone func() (interface{}, interface{})
+	}{
+		{name: "Request", clone: fillAndClone[Request]},
+		{name: "BidResponse", clone: fillAndClone[BidResponse]},
+		{name: "NativeRequest", clone: fillAndClone[NativeRequest]},
+		{name: "NativeResponse", clone: fillAndClone[NativeResponse]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, c := tt.clone()
+			if !reflect.DeepEqual(x, c) {
+				t.Fatalf("clone differs from the original\n got %+v\nwant %+v", c, x)
+			}
+			if p := shared(reflect.ValueOf(x), reflect.ValueOf(c), tt.name); p != "" {
+				t.Errorf("%s is shared by the clone", p)
+			}
+		})
+	}
+}
+
+func TestCloneFixtures(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+	}{
+		{name: "static", file: "./test_data/static_bid_request.json"},
+		{name: "video", file: "./test_data/video_bid_request.json"},
+		{name: "native", file: "./test_data/native_bid_request.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := loadRequest(t, tt.file)
+			c := req.Clone()
+			if !reflect.DeepEqual(req, c) {
+				t.Fatalf("clone differs from the original\n got %+v\nwant %+v", c, req)
+			}
+			if p := shared(reflect.ValueOf(req), reflect.ValueOf(c), "Request"); p != "" {
+				t.Errorf("%s is shared by the clone", p)
+			}
+
+			want, _ := json.Marshal(req)
+			c.Imp[0].ID = "changed"
+			got, _ := json.Marshal(req)
+			if string(got) != string(want) {
+				t.Error("changing the clone changed the original")
+			}
+		})
+	}
+
+	var nilReq *Request
+	if nilReq.Clone() != nil {
+		t.Error("the clone of nil should be nil")
+	}
+}
+
+// cloneTestFuncExt doesn't encode to JSON, for its func
+type cloneTestFuncExt struct {
+	IDs    []string            `json:"ids"`
+	Floors map[string]*float64 `json:"floors"`
+	OnBid  func()              `json:"-"`
+	Encode func()              `json:"encode"`
+}
+
+func TestCloneTyped(t *testing.T) {
+	floor := 1.5
+	tests := []struct {
+		name string
+		v    interface{}
+	}{
+		{name: "JSON", v: &cloneTestExt{IDs: []string{"a", "b"}}},
+		{name: "Not JSON", v: &cloneTestFuncExt{IDs: []string{"a"}, Floors: map[string]*float64{"USD": &floor}, Encode: func() {}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := cloneTyped(tt.v)
+			if reflect.TypeOf(c) != reflect.TypeOf(tt.v) {
+				t.Fatalf("clone is a %T, want %T", c, tt.v)
+			}
+			if p := shared(reflect.ValueOf(tt.v), reflect.ValueOf(c), tt.name); p != "" {
+				t.Errorf("%s is shared by the clone", p)
+			}
+		})
+	}
+}
+
+func BenchmarkClone(b *testing.B) {
+	req := loadRequest(b, "./test_data/video_bid_request.json")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		req.Clone()
+	}
+}
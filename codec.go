@@ -0,0 +1,958 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// The MarshalJSON and UnmarshalJSON methods of the objects are generated into json_gen.go, on top of the
+// jsonWriter and jsonReader here. They encode and decode exactly as encoding/json does from the struct tags,
+// without its reflection. encoding/json validates and compacts what a Marshaler returns, and validates its
+// input before calling an Unmarshaler, so calling the methods directly rather than through json.Marshal and
+// json.Unmarshal saves a further pass over the data
+
+// jsonWriter appends JSON to buf, err is the first value that couldn't be encoded
+type jsonWriter struct {
+	buf []byte
+	err error
+}
+
+const hex = "0123456789abcdef"
+
+// intSize is the size of int and uint
+const intSize = 32 << (^uint(0) >> 63)
+
+// htmlSafe are the ASCII characters encoding/json writes unescaped
+var htmlSafe = func() (s [utf8.RuneSelf]bool) {
+	for c := ' '; c < utf8.RuneSelf; c++ {
+		s[c] = c != '"' && c != '\\' && c != '<' && c != '>' && c != '&'
+	}
+	return s
+}()
+
+// field starts a field of an object, key is the quoted name followed by a colon
+func (w *jsonWriter) field(key string) {
+	if w.buf[len(w.buf)-1] != '{' {
+		w.buf = append(w.buf, ',')
+	}
+	w.buf = append(w.buf, key...)
+}
+
+// elem starts the element i of an array
+func (w *jsonWriter) elem(i int) {
+	if i > 0 {
+		w.buf = append(w.buf, ',')
+	}
+}
+
+func (w *jsonWriter) null() { w.buf = append(w.buf, "null"...) }
+
+func (w *jsonWriter) bool(v bool) { w.buf = strconv.AppendBool(w.buf, v) }
+
+func (w *jsonWriter) int(v int64) { w.buf = strconv.AppendInt(w.buf, v, 10) }
+
+func (w *jsonWriter) uint(v uint64) { w.buf = strconv.AppendUint(w.buf, v, 10) }
+
+// float writes v as an ES6 number, as encoding/json does
+func (w *jsonWriter) float(v float64, bits int) {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		if w.err == nil {
+			w.err = &json.UnsupportedValueError{Value: reflect.ValueOf(v), Str: strconv.FormatFloat(v, 'g', -1, bits)}
+		}
+		w.null()
+		return
+	}
+
+	abs := math.Abs(v)
+	format := byte('f')
+	if abs != 0 {
+		if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+			format = 'e'
+		}
+	}
+	w.buf = strconv.AppendFloat(w.buf, v, format, -1, bits)
+	if format == 'e' {
+		// e-09 is written e-9
+		if n := len(w.buf); n >= 4 && w.buf[n-4] == 'e' && w.buf[n-3] == '-' && w.buf[n-2] == '0' {
+			w.buf[n-2] = w.buf[n-1]
+			w.buf = w.buf[:n-1]
+		}
+	}
+}
+
+// string writes s quoted, escaping HTML characters and replacing invalid UTF-8 as encoding/json does
+func (w *jsonWriter) string(s string) {
+	w.buf = append(w.buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if htmlSafe[c] {
+				i++
+				continue
+			}
+			w.buf = append(w.buf, s[start:i]...)
+			switch c {
+			case '\\', '"':
+				w.buf = append(w.buf, '\\', c)
+			case '\b':
+				w.buf = append(w.buf, '\\', 'b')
+			case '\f':
+				w.buf = append(w.buf, '\\', 'f')
+			case '\n':
+				w.buf = append(w.buf, '\\', 'n')
+			case '\r':
+				w.buf = append(w.buf, '\\', 'r')
+			case '\t':
+				w.buf = append(w.buf, '\\', 't')
+			default:
+				w.buf = append(w.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	w.buf = append(w.buf, s[start:]...)
+	w.buf = append(w.buf, '"')
+}
+
+// raw writes a json.RawMessage, which like encoding/json must be valid and is compacted
+func (w *jsonWriter) raw(m json.RawMessage) {
+	if m == nil {
+		w.null()
+		return
+	}
+	if !json.Valid(m) {
+		if w.err == nil {
+			w.err = &json.MarshalerError{Type: reflect.TypeOf(m), Err: errors.New("invalid JSON in raw message")}
+		}
+		w.null()
+		return
+	}
+	w.compact(m)
+}
+
+// marshaler writes the encoding of a value with its own MarshalJSON method, which is trusted to be valid
+func (w *jsonWriter) marshaler(m json.Marshaler) {
+	b, err := m.MarshalJSON()
+	if err != nil {
+		if w.err == nil {
+			w.err = &json.MarshalerError{Type: reflect.TypeOf(m), Err: err}
+		}
+		w.null()
+		return
+	}
+	w.compact(b)
+}
+
+// value writes an arbitrary value with encoding/json
+func (w *jsonWriter) value(v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		w.null()
+		return
+	}
+	w.buf = append(w.buf, b...)
+}
+
+// compact writes valid JSON without insignificant space, escaping HTML characters in its strings
+func (w *jsonWriter) compact(b []byte) {
+	inString, escaped := false, false
+	start := 0
+	for i := 0; i < len(b); i++ {
+		c := b[i]
+		switch {
+		case c == '<' || c == '>' || c == '&':
+			w.buf = append(w.buf, b[start:i]...)
+			w.buf = append(w.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			start = i + 1
+		case c == 0xE2 && i+2 < len(b) && b[i+1] == 0x80 && b[i+2]&^1 == 0xA8:
+			w.buf = append(w.buf, b[start:i]...)
+			w.buf = append(w.buf, '\\', 'u', '2', '0', '2', hex[b[i+2]&0xF])
+			i += 2
+			start = i + 1
+		case inString:
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			w.buf = append(w.buf, b[start:i]...)
+			start = i + 1
+		}
+	}
+	w.buf = append(w.buf, b[start:]...)
+}
+
+// jsonReader decodes JSON from data. A syntax error stops the reading, a value of the wrong type is skipped
+// and the reading goes on, as it does in encoding/json
+type jsonReader struct {
+	data    []byte
+	pos     int
+	err     error // the syntax error, or the error of an Unmarshaler
+	typeErr error // the first value of the wrong type
+	key     []byte
+	start   int // of the last number read
+
+	// lenient readers coerce values of the wrong type instead, see lenient.go
+	lenient   bool
+	coercions []Coercion
+	path      []pathElem
+	coerced   bool // the value being read was coerced
+}
+
+// done returns the error of the reading, which must have consumed the data
+func (r *jsonReader) done() error {
+	if r.err == nil {
+		if r.ws(); r.pos < len(r.data) {
+			r.syntaxError()
+		}
+	}
+	if r.err != nil {
+		return r.err
+	}
+	return r.typeErr
+}
+
+// syntaxError stops the reading. The error is the one encoding/json returns for the data, so that they match
+func (r *jsonReader) syntaxError() {
+	if r.err != nil {
+		return
+	}
+	var v interface{}
+	r.err = json.Unmarshal(r.data, &v)
+	if r.err == nil {
+		r.err = errors.New("twofive: invalid JSON at offset " + strconv.Itoa(r.pos))
+	}
+	r.pos = len(r.data)
+}
+
+// fail stops the reading with the error of an Unmarshaler
+func (r *jsonReader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+		r.pos = len(r.data)
+	}
+}
+
+// mismatch records that the next value isn't of the type t and skips it
+func (r *jsonReader) mismatch(t reflect.Type) {
+	start := r.pos
+	if r.lenient {
+		r.skip()
+		r.drop(start, t)
+		return
+	}
+	value := "number"
+	switch r.data[r.pos] {
+	case '"':
+		value = "string"
+	case '{':
+		value = "object"
+	case '[':
+		value = "array"
+	case 't', 'f':
+		value = "bool"
+	}
+	r.skip()
+	if value == "number" && r.err == nil {
+		value += " " + string(r.data[start:r.pos])
+	}
+	if r.typeErr == nil {
+		r.typeErr = &json.UnmarshalTypeError{Value: value, Type: t, Offset: int64(r.pos), Field: string(r.key)}
+	}
+}
+
+func (r *jsonReader) ws() {
+	for r.pos < len(r.data) {
+		switch r.data[r.pos] {
+		case ' ', '\t', '\n', '\r':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the first byte of the next value, a syntax error if there's none
+func (r *jsonReader) peek() (byte, bool) {
+	if r.err != nil {
+		return 0, false
+	}
+	if r.ws(); r.pos == len(r.data) {
+		r.syntaxError()
+		return 0, false
+	}
+	return r.data[r.pos], true
+}
+
+// literal consumes the literal s
+func (r *jsonReader) literal(s string) {
+	if len(r.data)-r.pos < len(s) || string(r.data[r.pos:r.pos+len(s)]) != s {
+		r.syntaxError()
+		return
+	}
+	r.pos += len(s)
+}
+
+// null consumes the next value if it's null
+func (r *jsonReader) null() bool {
+	if c, ok := r.peek(); !ok || c != 'n' {
+		return false
+	}
+	r.literal("null")
+	return r.err == nil
+}
+
+// object consumes the start of an object, to be decoded into v, and returns its first key. It returns false
+// on an empty object, a null, which leaves v as it is, or a value that isn't an object
+func (r *jsonReader) object(v interface{}) ([]byte, bool) {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return nil, false
+	case c == 'n':
+		r.literal("null")
+		return nil, false
+	case c != '{':
+		r.mismatch(reflect.TypeOf(v).Elem())
+		return nil, false
+	}
+	r.pos++
+	if c, ok := r.peek(); ok && c == '}' {
+		r.pos++
+		return nil, false
+	}
+	key, ok := r.readKey()
+	if ok && r.lenient {
+		r.path = append(r.path, pathElem{key: key})
+	}
+	return key, ok
+}
+
+// next returns the next key of an object, false at its end
+func (r *jsonReader) next() ([]byte, bool) {
+	key, ok := r.nextKey()
+	if r.lenient {
+		if ok {
+			r.path[len(r.path)-1].key = key
+		} else {
+			r.path = r.path[:len(r.path)-1]
+		}
+	}
+	return key, ok
+}
+
+func (r *jsonReader) nextKey() ([]byte, bool) {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return nil, false
+	case c == '}':
+		r.pos++
+		return nil, false
+	case c != ',':
+		r.syntaxError()
+		return nil, false
+	}
+	r.pos++
+	return r.readKey()
+}
+
+func (r *jsonReader) readKey() ([]byte, bool) {
+	if c, ok := r.peek(); !ok || c != '"' {
+		r.syntaxError()
+		return nil, false
+	}
+	key, ok := r.unquote()
+	if !ok {
+		return nil, false
+	}
+	if c, ok := r.peek(); !ok || c != ':' {
+		r.syntaxError()
+		return nil, false
+	}
+	r.pos++
+	r.key = key
+	return key, true
+}
+
+// array consumes the start of an array, to be decoded into v, false if the value isn't one
+func (r *jsonReader) array(v interface{}) bool {
+	c, ok := r.peek()
+	if !ok {
+		return false
+	}
+	if c != '[' {
+		r.mismatch(reflect.TypeOf(v).Elem())
+		return false
+	}
+	r.pos++
+	if r.lenient {
+		r.path = append(r.path, pathElem{array: true})
+	}
+	return true
+}
+
+// elem reports if the array has an element i, consuming the comma before it
+func (r *jsonReader) elem(i int) bool {
+	ok := r.nextElem(i)
+	if r.lenient {
+		if ok {
+			r.path[len(r.path)-1].index = i
+		} else {
+			r.path = r.path[:len(r.path)-1]
+		}
+	}
+	return ok
+}
+
+func (r *jsonReader) nextElem(i int) bool {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return false
+	case c == ']':
+		r.pos++
+		return false
+	case i == 0:
+		return true
+	case c != ',':
+		r.syntaxError()
+		return false
+	}
+	r.pos++
+	return true
+}
+
+var (
+	stringType = reflect.TypeOf("")
+	boolType   = reflect.TypeOf(false)
+)
+
+// numberTypes are the numeric types by kind and size, for the errors of values of another type
+var numberTypes = []reflect.Type{
+	reflect.TypeOf(int8(0)), reflect.TypeOf(int16(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint8(0)), reflect.TypeOf(uint16(0)), reflect.TypeOf(uint32(0)), reflect.TypeOf(uint64(0)),
+	reflect.TypeOf(float32(0)), reflect.TypeOf(float64(0)),
+}
+
+// numberType returns the type of the kind, reflect.Int, reflect.Uint or reflect.Float64, and size
+func numberType(kind reflect.Kind, bits int) reflect.Type {
+	i := map[reflect.Kind]int{reflect.Int: 0, reflect.Uint: 4, reflect.Float64: 6}[kind]
+	for b := 8; b < bits; b *= 2 {
+		i++
+	}
+	return numberTypes[i]
+}
+
+// string reads a string, false on a null or a value of another type
+func (r *jsonReader) string() (string, bool) {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return "", false
+	case c == 'n':
+		r.literal("null")
+		return "", false
+	case c != '"':
+		if r.lenient {
+			return r.coerceString()
+		}
+		r.mismatch(stringType)
+		return "", false
+	}
+	s, ok := r.unquote()
+	return string(s), ok
+}
+
+// bool reads a bool, false on a null or a value of another type
+func (r *jsonReader) bool() (bool, bool) {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return false, false
+	case c == 'n':
+		r.literal("null")
+		return false, false
+	case c == 't':
+		r.literal("true")
+		return true, r.err == nil
+	case c == 'f':
+		r.literal("false")
+		return false, r.err == nil
+	}
+	if r.lenient {
+		return r.coerceBool()
+	}
+	r.mismatch(boolType)
+	return false, false
+}
+
+// number reads a number literal, false on a null or a value of another type
+func (r *jsonReader) number(kind reflect.Kind, bits int) ([]byte, bool) {
+	c, ok := r.peek()
+	switch {
+	case !ok:
+		return nil, false
+	case c == 'n':
+		r.literal("null")
+		return nil, false
+	case c != '-' && (c < '0' || c > '9'):
+		if r.lenient {
+			return r.coerceNumber(kind, bits)
+		}
+		r.mismatch(numberType(kind, bits))
+		return nil, false
+	}
+
+	r.start, r.coerced = r.pos, false
+	if r.scanNumber(); r.err != nil {
+		return nil, false
+	}
+	return r.data[r.start:r.pos], true
+}
+
+// int reads an integer of the size, a number that isn't one or doesn't fit is of the wrong type
+func (r *jsonReader) int(bits int) (int64, bool) {
+	b, ok := r.number(reflect.Int, bits)
+	if !ok {
+		return 0, false
+	}
+
+	neg := b[0] == '-'
+	digits := b
+	if neg {
+		digits = b[1:]
+	}
+	var n uint64
+	for _, c := range digits {
+		if c < '0' || c > '9' || n > (math.MaxUint64-9)/10 {
+			return r.parseInt(b, bits)
+		}
+		n = n*10 + uint64(c-'0')
+	}
+	limit := uint64(1) << (bits - 1)
+	if neg && n <= limit {
+		return -int64(n), true
+	}
+	if !neg && n < limit {
+		return int64(n), true
+	}
+	return r.parseInt(b, bits)
+}
+
+// parseInt is the slow path of int, for numbers with a fraction or exponent and those that don't fit
+func (r *jsonReader) parseInt(b []byte, bits int) (int64, bool) {
+	n, err := strconv.ParseInt(string(b), 10, bits)
+	if err != nil {
+		if r.lenient {
+			return r.coerceInt(b, bits)
+		}
+		r.numberMismatch(b, numberType(reflect.Int, bits))
+		return 0, false
+	}
+	return n, true
+}
+
+// uint reads an unsigned integer of the size
+func (r *jsonReader) uint(bits int) (uint64, bool) {
+	b, ok := r.number(reflect.Uint, bits)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(string(b), 10, bits)
+	if err != nil {
+		if r.lenient {
+			return r.coerceUint(b, bits)
+		}
+		r.numberMismatch(b, numberType(reflect.Uint, bits))
+		return 0, false
+	}
+	return n, true
+}
+
+// pow10 are the powers of ten exactly representable as a float64
+var pow10 = [...]float64{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12, 1e13, 1e14, 1e15,
+	1e16, 1e17, 1e18, 1e19, 1e20, 1e21, 1e22}
+
+// float reads a float of the size
+func (r *jsonReader) float(bits int) (float64, bool) {
+	b, ok := r.number(reflect.Float64, bits)
+	if !ok {
+		return 0, false
+	}
+
+	// a decimal with a mantissa and a power of ten that are both exact is correctly rounded by one division,
+	// anything else is left to strconv
+	if bits == 64 {
+		var mantissa uint64
+		frac, digits := -1, 0
+		neg := b[0] == '-'
+		i := 0
+		if neg {
+			i = 1
+		}
+		for ; i < len(b); i++ {
+			c := b[i]
+			if c == '.' && frac < 0 {
+				frac = 0
+				continue
+			}
+			if c < '0' || c > '9' {
+				break
+			}
+			mantissa = mantissa*10 + uint64(c-'0')
+			if digits++; frac >= 0 {
+				frac++
+			}
+		}
+		if i == len(b) && digits <= 15 && frac < len(pow10) {
+			f := float64(mantissa)
+			if frac > 0 {
+				f /= pow10[frac]
+			}
+			if neg {
+				f = -f
+			}
+			return f, true
+		}
+	}
+
+	f, err := strconv.ParseFloat(string(b), bits)
+	if err != nil {
+		r.numberMismatch(b, numberType(reflect.Float64, bits))
+		return 0, false
+	}
+	return f, true
+}
+
+func (r *jsonReader) numberMismatch(b []byte, t reflect.Type) {
+	if r.lenient && r.coerced {
+		r.coercions[len(r.coercions)-1].Dropped = true
+		return
+	}
+	if r.lenient {
+		r.drop(r.start, t)
+		return
+	}
+	if r.typeErr == nil {
+		r.typeErr = &json.UnmarshalTypeError{Value: "number " + string(b), Type: t, Offset: int64(r.pos), Field: string(r.key)}
+	}
+}
+
+// scanNumber consumes a number literal, which must follow the JSON grammar
+func (r *jsonReader) scanNumber() {
+	d := r.data
+	i := r.pos
+	if i < len(d) && d[i] == '-' {
+		i++
+	}
+	switch {
+	case i < len(d) && d[i] == '0':
+		i++
+	case i < len(d) && d[i] >= '1' && d[i] <= '9':
+		for i++; i < len(d) && d[i] >= '0' && d[i] <= '9'; i++ {
+		}
+	default:
+		r.pos = i
+		r.syntaxError()
+		return
+	}
+	if i < len(d) && d[i] == '.' {
+		i++
+		if i == len(d) || d[i] < '0' || d[i] > '9' {
+			r.syntaxError()
+			return
+		}
+		for ; i < len(d) && d[i] >= '0' && d[i] <= '9'; i++ {
+		}
+	}
+	if i < len(d) && (d[i] == 'e' || d[i] == 'E') {
+		i++
+		if i < len(d) && (d[i] == '+' || d[i] == '-') {
+			i++
+		}
+		if i == len(d) || d[i] < '0' || d[i] > '9' {
+			r.syntaxError()
+			return
+		}
+		for ; i < len(d) && d[i] >= '0' && d[i] <= '9'; i++ {
+		}
+	}
+	r.pos = i
+}
+
+// unquote consumes a string and returns its contents, a slice of data when there's nothing to unescape
+func (r *jsonReader) unquote() ([]byte, bool) {
+	d := r.data
+	start := r.pos + 1
+	i := start
+	for i < len(d) {
+		c := d[i]
+		if c == '"' {
+			r.pos = i + 1
+			return d[start:i], true
+		}
+		if c == '\\' || c < ' ' {
+			break
+		}
+		if c < utf8.RuneSelf {
+			i++
+			continue
+		}
+		rr, size := utf8.DecodeRune(d[i:])
+		if rr == utf8.RuneError && size == 1 {
+			break
+		}
+		i += size
+	}
+
+	b := make([]byte, 0, i-start+16)
+	b = append(b, d[start:i]...)
+	for i < len(d) {
+		c := d[i]
+		switch {
+		case c == '"':
+			r.pos = i + 1
+			return b, true
+		case c < ' ':
+			r.pos = i
+			r.syntaxError()
+			return nil, false
+		case c == '\\':
+			i++
+			if i == len(d) {
+				r.syntaxError()
+				return nil, false
+			}
+			switch d[i] {
+			case '"', '\\', '/':
+				b = append(b, d[i])
+			case 'b':
+				b = append(b, '\b')
+			case 'f':
+				b = append(b, '\f')
+			case 'n':
+				b = append(b, '\n')
+			case 'r':
+				b = append(b, '\r')
+			case 't':
+				b = append(b, '\t')
+			case 'u':
+				rr := getu4(d[i-1:])
+				if rr < 0 {
+					r.syntaxError()
+					return nil, false
+				}
+				i += 4
+				if utf16.IsSurrogate(rr) {
+					if dec := utf16.DecodeRune(rr, getu4(d[i+1:])); dec != unicode.ReplacementChar {
+						// a valid pair
+						b = utf8.AppendRune(b, dec)
+						i += 7
+						continue
+					}
+					rr = unicode.ReplacementChar
+				}
+				b = utf8.AppendRune(b, rr)
+			default:
+				r.syntaxError()
+				return nil, false
+			}
+			i++
+		case c < utf8.RuneSelf:
+			b = append(b, c)
+			i++
+		default:
+			// invalid UTF-8 is replaced, as encoding/json does
+			rr, size := utf8.DecodeRune(d[i:])
+			b = utf8.AppendRune(b, rr)
+			i += size
+		}
+	}
+	r.syntaxError()
+	return nil, false
+}
+
+// getu4 decodes \uXXXX from the start of s, -1 if it isn't one
+func getu4(s []byte) rune {
+	if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+		return -1
+	}
+	var r rune
+	for _, c := range s[2:6] {
+		switch {
+		case '0' <= c && c <= '9':
+			c = c - '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return -1
+		}
+		r = r*16 + rune(c)
+	}
+	return r
+}
+
+// skip consumes the next value, whatever it is
+func (r *jsonReader) skip() {
+	c, ok := r.peek()
+	if !ok {
+		return
+	}
+	switch {
+	case c == '{':
+		for _, ok := r.object(nil); ok; _, ok = r.next() {
+			r.skip()
+		}
+	case c == '[':
+		r.array(nil)
+		for i := 0; r.elem(i); i++ {
+			r.skip()
+		}
+	case c == '"':
+		r.unquote()
+	case c == 't':
+		r.literal("true")
+	case c == 'f':
+		r.literal("false")
+	case c == 'n':
+		r.literal("null")
+	case c == '-' || (c >= '0' && c <= '9'):
+		r.scanNumber()
+	default:
+		r.syntaxError()
+	}
+}
+
+// raw consumes the next value and returns it as it is in data
+func (r *jsonReader) raw() ([]byte, bool) {
+	if _, ok := r.peek(); !ok {
+		return nil, false
+	}
+	start := r.pos
+	r.skip()
+	return r.data[start:r.pos], r.err == nil
+}
+
+// rawMessage reads the next value into m, as json.RawMessage does, reusing its memory
+func (r *jsonReader) rawMessage(m *json.RawMessage) {
+	if b, ok := r.raw(); ok {
+		*m = append((*m)[:0], b...)
+	}
+}
+
+// unmarshaler reads the next value with the Unmarshaler, the error of which stops the reading
+func (r *jsonReader) unmarshaler(u json.Unmarshaler) {
+	if b, ok := r.raw(); ok {
+		if err := u.UnmarshalJSON(b); err != nil {
+			r.fail(err)
+		}
+	}
+}
+
+// value reads the next value into v with encoding/json
+func (r *jsonReader) value(v interface{}) {
+	if b, ok := r.raw(); ok {
+		if err := json.Unmarshal(b, v); err != nil {
+			r.fail(err)
+		}
+	}
+}
+
+// foldKey returns the key folded as encoding/json does to match it to a field case insensitively, nil if it's
+// folded already
+func foldKey(key []byte) []byte {
+	for i := 0; i < len(key); i++ {
+		if c := key[i]; c >= utf8.RuneSelf || ('a' <= c && c <= 'z') {
+			if folded := appendFolded(make([]byte, 0, len(key)+utf8.UTFMax), key); !bytes.Equal(folded, key) {
+				return folded
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+func appendFolded(out, in []byte) []byte {
+	for i := 0; i < len(in); {
+		if c := in[i]; c < utf8.RuneSelf {
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			out = append(out, c)
+			i++
+			continue
+		}
+		r, n := utf8.DecodeRune(in[i:])
+		out = utf8.AppendRune(out, foldRune(r))
+		i += n
+	}
+	return out
+}
+
+// foldRune returns the smallest rune of the fold set of r
+func foldRune(r rune) rune {
+	for {
+		r2 := unicode.SimpleFold(r)
+		if r2 <= r {
+			return r2
+		}
+		r = r2
+	}
+}
+
+// grow returns s with an element i, reusing its memory and any element already there as encoding/json does
+func grow[T any](s []T, i int) []T {
+	if i < len(s) {
+		return s
+	}
+	if i < cap(s) {
+		return s[:i+1]
+	}
+	var zero T
+	return append(s, zero)
+}
+
+// truncate returns s with n elements, an empty array decodes to an empty slice rather than nil
+func truncate[T any](s []T, n int) []T {
+	if n == 0 {
+		return []T{}
+	}
+	return s[:n]
+}
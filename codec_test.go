@@ -0,0 +1,464 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var mirrors = make(map[reflect.Type]reflect.Type)
+
+// mirror returns a type with the fields and tags of t but none of the generated methods, so encoding/json
+// encodes it by reflection as it did the objects before they were generated. The ext types are replaced
+// by their refExt
+func mirror(t reflect.Type) reflect.Type {
+	if m, ok := mirrors[t]; ok {
+		return m
+	}
+
+	m := t
+	switch t.Kind() {
+	case reflect.Ptr:
+		m = reflect.PtrTo(mirror(t.Elem()))
+	case reflect.Slice:
+		if t != reflect.TypeOf(json.RawMessage(nil)) {
+			m = reflect.SliceOf(mirror(t.Elem()))
+		}
+	case reflect.Struct:
+		if ref, ok := refExts[t]; ok {
+			m = ref
+			break
+		}
+		var fields []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.IsExported() {
+				f.Type = mirror(f.Type)
+				fields = append(fields, f)
+			}
+		}
+		m = reflect.StructOf(fields)
+	}
+	mirrors[t] = m
+	return m
+}
+
+// convert copies src into dst, where one is the mirror of the other
+func convert(dst, src reflect.Value) {
+	if dst.Type() == src.Type() {
+		dst.Set(src)
+		return
+	}
+	if refExts[src.Type()] == dst.Type() {
+		dst.Field(0).Set(src)
+		return
+	}
+	if refExts[dst.Type()] == src.Type() {
+		dst.Set(src.Field(0))
+		return
+	}
+	switch src.Kind() {
+	case reflect.Ptr:
+		if !src.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+			convert(dst.Elem(), src.Elem())
+		}
+	case reflect.Slice:
+		if !src.IsNil() {
+			dst.Set(reflect.MakeSlice(dst.Type(), src.Len(), src.Len()))
+			for i := 0; i < src.Len(); i++ {
+				convert(dst.Index(i), src.Index(i))
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < dst.NumField(); i++ {
+			if f := dst.Type().Field(i); f.IsExported() && !f.Anonymous {
+				convert(dst.Field(i), src.FieldByName(f.Name))
+			}
+		}
+	}
+}
+
+// refExt is the reference encoding of the ext type T, by reflection as it was before it was generated. The
+// modelled fields are encoded by encoding/json, followed by the raw keys in the order they were decoded
+type refExt[T any] struct {
+	Ext T
+}
+
+var refExts = make(map[reflect.Type]reflect.Type)
+
+func addRefExt[T any]() {
+	refExts[reflect.TypeOf((*T)(nil)).Elem()] = reflect.TypeOf(refExt[T]{})
+}
+
+func init() {
+	addRefExt[RequestExt]()
+	addRefExt[SourceExt]()
+	addRefExt[RegsExt]()
+	addRefExt[ImpExt]()
+	addRefExt[MetricExt]()
+	addRefExt[BannerExt]()
+	addRefExt[VideoExt]()
+	addRefExt[AudioExt]()
+	addRefExt[NativeExt]()
+	addRefExt[FormatExt]()
+	addRefExt[AppExt]()
+	addRefExt[SiteExt]()
+	addRefExt[PublisherExt]()
+	addRefExt[ContentExt]()
+	addRefExt[ProducerExt]()
+	addRefExt[DeviceExt]()
+	addRefExt[GeoExt]()
+	addRefExt[UserExt]()
+	addRefExt[DataExt]()
+	addRefExt[SegmentExt]()
+	addRefExt[PMPExt]()
+	addRefExt[DealExt]()
+	addRefExt[BidResponseExt]()
+	addRefExt[SeatbidExt]()
+	addRefExt[BidExt]()
+}
+
+// known returns the modelled fields of the ext, and its extensions
+func (e *refExt[T]) known() (reflect.Value, *Extensions) {
+	ext := reflect.ValueOf(&e.Ext).Elem()
+	var fields []reflect.StructField
+	for i := 0; i < ext.NumField(); i++ {
+		if f := ext.Type().Field(i); f.IsExported() && !f.Anonymous {
+			f.Type = mirror(f.Type)
+			fields = append(fields, f)
+		}
+	}
+	known := reflect.New(reflect.StructOf(fields))
+	convert(known.Elem(), ext)
+	return known, ext.FieldByName("Extensions").Addr().Interface().(*Extensions)
+}
+
+// isKnown reports if key is decoded into one of the fields of t, which like encoding/json is case insensitive
+func isKnown(t reflect.Type, key string) bool {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = f.Name
+		}
+		if strings.EqualFold(name, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e refExt[T]) MarshalJSON() ([]byte, error) {
+	known, ext := e.known()
+	b, err := json.Marshal(known.Interface())
+	if err != nil || len(ext.raw) == 0 && len(ext.typed) == 0 {
+		return b, err
+	}
+
+	b = b[:len(b)-1]
+	for _, k := range ext.keys() {
+		if isKnown(known.Elem().Type(), k) {
+			continue
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		v, ok := ext.raw[k]
+		if t, isTyped := ext.typed[k]; isTyped {
+			if v, err = json.Marshal(t); err != nil {
+				return nil, err
+			}
+		} else if !ok {
+			continue
+		}
+		if len(b) > 1 {
+			b = append(b, ',')
+		}
+		b = append(b, key...)
+		b = append(b, ':')
+		b = append(b, v...)
+	}
+	return append(b, '}'), nil
+}
+
+func (e *refExt[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	known, ext := e.known()
+	err := json.Unmarshal(data, known.Interface())
+	convert(reflect.ValueOf(&e.Ext).Elem(), known.Elem())
+	if err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	for k := range raw {
+		if isKnown(known.Elem().Type(), k) {
+			delete(raw, k)
+		}
+	}
+
+	ext.raw, ext.typed, ext.order = nil, nil, nil
+	for _, k := range objectKeys(data) {
+		if _, ok := raw[k]; ok && !contains(ext.order, k) {
+			ext.order = append(ext.order, k)
+		}
+	}
+	for k, t := range registered(reflect.TypeOf(e.Ext)) {
+		v, ok := raw[k]
+		if !ok {
+			continue
+		}
+		typed := reflect.New(t)
+		if err := json.Unmarshal(v, typed.Interface()); err != nil {
+			return err
+		}
+		if ext.typed == nil {
+			ext.typed = make(map[string]interface{})
+		}
+		ext.typed[k] = typed.Interface()
+		delete(raw, k)
+	}
+	if len(raw) > 0 {
+		ext.raw = raw
+	}
+	return nil
+}
+
+// objectKeys returns the keys of the JSON object in data in order, duplicates included
+func objectKeys(data []byte) []string {
+	d := json.NewDecoder(bytes.NewReader(data))
+	if _, err := d.Token(); err != nil {
+		return nil
+	}
+	var keys []string
+	for d.More() {
+		k, err := d.Token()
+		if err != nil {
+			return keys
+		}
+		var v json.RawMessage
+		if err := d.Decode(&v); err != nil {
+			return keys
+		}
+		keys = append(keys, k.(string))
+	}
+	return keys
+}
+
+// checkJSON decodes data into a T and into its mirror with encoding/json, and fails unless both succeed or
+// fail alike and, when they succeed, decode and encode the same
+func checkJSON[T any, P interface {
+	*T
+	json.Unmarshaler
+}](t *testing.T, data []byte) {
+	t.Helper()
+
+	var got T
+	gotErr := P(&got).UnmarshalJSON(data)
+	ref := reflect.New(mirror(reflect.TypeOf(got)))
+	wantErr := json.Unmarshal(data, ref.Interface())
+	if (gotErr == nil) != (wantErr == nil) {
+		t.Fatalf("UnmarshalJSON(%s) error = %v, encoding/json %v", data, gotErr, wantErr)
+	}
+	if gotErr != nil {
+		return
+	}
+
+	var want T
+	convert(reflect.ValueOf(&want).Elem(), ref.Elem())
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("UnmarshalJSON(%s)\n got %+v\nwant %+v", data, got, want)
+	}
+
+	gotJSON, err := interface{}(got).(json.Marshaler).MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantJSON, err := json.Marshal(ref.Interface())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// raw ext values are written as they were read, which encoding/json compacts and escapes in the reference
+	var normalized bytes.Buffer
+	if err := json.Compact(&normalized, gotJSON); err != nil {
+		t.Fatalf("MarshalJSON() = %s, %v", gotJSON, err)
+	}
+	var escaped bytes.Buffer
+	json.HTMLEscape(&escaped, normalized.Bytes())
+	if !bytes.Equal(escaped.Bytes(), wantJSON) {
+		t.Fatalf("MarshalJSON()\n got %s\nwant %s", gotJSON, wantJSON)
+	}
+}
+
+var jsonFixtures = []string{
+	"./test_data/static_bid_request.json",
+	"./test_data/video_bid_request.json",
+	"./test_data/site_bid_request.json",
+	"./test_data/native_bid_request.json",
+	"./test_data/native_bid_response.json",
+}
+
+func TestJSON(t *testing.T) {
+	for _, file := range jsonFixtures {
+		t.Run(file, func(t *testing.T) {
+			b, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checkJSON[Request](t, b)
+			checkJSON[BidResponse](t, b)
+		})
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "empty", data: `{}`},
+		{name: "null", data: `null`},
+		{name: "whitespace", data: " \t\n{ \"id\" : \"1\" , \"imp\" : [ { \"id\" : \"1\" } ] }\r\n"},
+		{name: "escapes", data: `{"id":"\"\\\/\b\f\n\r\t\u0001<>& é😀\ud800x"}`},
+		{name: "html", data: `{"id":"<script>&amp;</script>","imp":[{"id":"1","ext":{"a":"<b>", "c" : [1, 2]}}]}`},
+		{name: "invalid utf8", data: "{\"id\":\"\xff\xfe\xe2\x80\xa8\"}"},
+		{name: "case insensitive keys", data: `{"ID":"1","Imp":[{"Id":"2","BANNER":{"W":300}}],"at":2}`},
+		{name: "quirky tags", data: `{"site":{"content":{"Delivery":[1,2]}},"device":{"device_type":4}}`},
+		{name: "duplicate keys", data: `{"id":"1","id":"2","imp":[{"id":"a","tagid":"t"}],"imp":[{"id":"b"}]}`},
+		{name: "nulls", data: `{"id":null,"imp":null,"site":null,"tmax":null,"ext":null,"regs":{"ext":null}}`},
+		{name: "empty arrays", data: `{"imp":[],"badv":[],"bcat":[]}`},
+		{name: "numbers", data: `{"tmax":-0,"imp":[{"id":"1","bidfloor":1.5e-7,"banner":{"w":9007199254740993}}],"device":{"geo":{"lat":-0.0,"lon":1e21}}}`},
+		{name: "unknown keys", data: `{"foo":{"bar":[1,{"baz":null}],"qux":"A"},"id":"1"}`},
+		{name: "interface", data: `{"imp":[{"id":"1","ext":{"aps":[{"amzn_b":{"a":[1,"2",null,true]},"amzn_h":"h"}]}}]}`},
+		{name: "seatbid", data: `{"id":"1","seatbid":[{"seat":"s","bid":[{"id":"1","impid":"1","price":0.1,"adomain":["a.com"],"ext":{"k":1}}]}],"cur":"USD"}`},
+		{name: "wrong type", data: `{"id":1,"imp":[{"id":"1"}]}`},
+		{name: "wrong type in array", data: `{"imp":[{"id":"1","banner":{"battr":[1,"2",3]}}]}`},
+		{name: "fraction into int", data: `{"tmax":1.5}`},
+		{name: "overflow", data: `{"tmax":99999999999999999999}`},
+		{name: "object into string", data: `{"id":{}}`},
+		{name: "string into object", data: `{"site":"x"}`},
+		{name: "ext keys", data: `{"regs":{"ext":{"GDPR":1,"gdpr":0,"x":{ "y" : "<z>" }}},"ext":{"b":1,"a":[ 2 ],"api_key":"k"}}`},
+		{name: "registered ext", data: `{"imp":[{"id":"1","ext":{"prebid":{"bidder":{"a":1}},"position":"top"}}]}`},
+		{name: "registered ext of wrong type", data: `{"imp":[{"id":"1","ext":{"prebid":[]}}]}`},
+		{name: "ext of wrong type", data: `{"regs":{"ext":{"gdpr":"1"}}}`},
+		{name: "syntax error", data: `{"id":"1",}`},
+		{name: "missing comma", data: `{"id":"1" "at":1}`},
+		{name: "trailing data", data: `{"id":"1"} x`},
+		{name: "bad number", data: `{"tmax":01}`},
+		{name: "bad escape", data: `{"id":"\x"}`},
+		{name: "unterminated", data: `{"id":"1`},
+		{name: "control character", data: "{\"id\":\"\x01\"}"},
+		{name: "empty input", data: ``},
+	}
+
+	type prebid struct {
+		Bidder map[string]json.RawMessage `json:"bidder"`
+	}
+	RegisterExt[*Imp]("prebid", prebid{})
+	defer RegisterExt[*Imp]("prebid", nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkJSON[Request](t, []byte(tt.data))
+			checkJSON[BidResponse](t, []byte(tt.data))
+		})
+	}
+}
+
+func TestJSONThroughEncodingJSON(t *testing.T) {
+	r := loadRequest(t, "./test_data/video_bid_request.json")
+	r.ID = "<script>&\u2028" // escaped alike
+	direct, err := r.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	indirect, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(direct, indirect) {
+		t.Errorf("MarshalJSON() and json.Marshal differ\n%s\n%s", direct, indirect)
+	}
+
+	var decoded Request
+	if err := decoded.UnmarshalJSON(direct); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, r) {
+		t.Errorf("round trip\n got %+v\nwant %+v", decoded, r)
+	}
+}
+
+func FuzzJSON(f *testing.F) {
+	for _, file := range jsonFixtures {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Add([]byte(`{"ID":"1","imp":[{"id":"1","banner":{"w":"x","format":[{"w":1},{"h":2}]}}],"ext":{"k":[1]}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		checkJSON[Request](t, data)
+		checkJSON[BidResponse](t, data)
+	})
+}
+
+func BenchmarkJSON(b *testing.B) {
+	for _, file := range []string{"./test_data/static_bid_request.json", "./test_data/video_bid_request.json"} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		req := loadRequest(b, file)
+		ref := reflect.New(mirror(reflect.TypeOf(Request{})))
+		convert(ref.Elem(), reflect.ValueOf(req).Elem())
+
+		b.Run(file[12:]+"/Unmarshal/generated", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				var r Request
+				if err := r.UnmarshalJSON(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(file[12:]+"/Unmarshal/encoding_json", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				r := reflect.New(ref.Type().Elem()).Interface()
+				if err := json.Unmarshal(data, r); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(file[12:]+"/Marshal/generated", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := req.MarshalJSON(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(file[12:]+"/Marshal/encoding_json", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				if _, err := json.Marshal(ref.Interface()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
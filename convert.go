@@ -0,0 +1,240 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/timehop/ortb-twofive/twosix"
+)
+
+// Loss is a value a conversion between OpenRTB 2.5 and 2.6 couldn't carry over, by the JSON path of the value
+// in the request converted, e.g. imp[0].video.ext.plcmt
+type Loss struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+func (l Loss) String() string {
+	return l.Path + ": " + l.Reason
+}
+
+// ToV26 converts the request to OpenRTB 2.6, moving the fields 2.5 carries in ext to their 2.6 homes: regs.gdpr,
+// regs.us_privacy, regs.gpp and regs.gpp_sid, user.consent and user.eids, source.schain, imp.rwdd, from the
+// is_rewarded_inventory of its ext, and imp.video.plcmt. The fields 2.6 doesn't have, and the values that don't
+// convert, are left out and returned as losses. The non spec format is carried in the ext of the request. The
+// request isn't modified, and nil converts to nil
+func ToV26(r *Request) (*twosix.BidRequest, []Loss) {
+	if r == nil {
+		return nil, nil
+	}
+	var l conversion
+	v := new(twosix.BidRequest)
+	r.toV26(v, &l, "")
+	if !isZero(r.Format) {
+		l.putExt("ext", &v.Ext, "format", r.Format)
+	}
+
+	if v.Regs != nil {
+		var gdpr int
+		if l.takeExt("regs.ext", &v.Regs.Ext, "gdpr", &gdpr) {
+			v.Regs.GDPR = &gdpr
+		}
+		l.takeExt("regs.ext", &v.Regs.Ext, "us_privacy", &v.Regs.USPrivacy)
+		if v.Regs.GPP == "" {
+			l.takeExt("regs.ext", &v.Regs.Ext, "gpp", &v.Regs.GPP)
+			l.takeExt("regs.ext", &v.Regs.Ext, "gpp_sid", &v.Regs.GPPSID)
+		}
+	}
+	if v.User != nil {
+		l.takeExt("user.ext", &v.User.Ext, "consent", &v.User.Consent)
+		l.takeExt("user.ext", &v.User.Ext, "eids", &v.User.EIDs)
+	}
+	if v.Source != nil {
+		l.takeExt("source.ext", &v.Source.Ext, "schain", &v.Source.SChain)
+	}
+	for i := range v.Imp {
+		imp := &v.Imp[i]
+		path := "imp[" + strconv.Itoa(i) + "]"
+		l.takeExt(path+".ext", &imp.Ext, "is_rewarded_inventory", &imp.Rwdd)
+		if imp.Video != nil {
+			l.takeExt(path+".video.ext", &imp.Video.Ext, "plcmt", &imp.Video.Plcmt)
+		}
+	}
+	return v, l.losses
+}
+
+// FromV26 converts an OpenRTB 2.6 request to 2.5, moving the fields ToV26 moves out of ext back into it. The
+// format is taken from the ext of the request, and left zero without one, nothing that isn't in the request is
+// added. The fields 2.5 doesn't have, and the values that don't convert, are left out and returned as losses.
+// nil converts to nil
+func FromV26(v *twosix.BidRequest) (*Request, []Loss) {
+	if v == nil {
+		return nil, nil
+	}
+	var l conversion
+	r := new(Request)
+	r.fromV26(v, &l, "")
+	if err := r.Ext.GetExt("format", &r.Format); err == nil {
+		r.Ext.SetExt("format", nil)
+	} else {
+		if err != ErrExtNotFound {
+			l.lose("ext.format", "invalid, left in ext")
+		}
+		r.Format = Format{}
+	}
+
+	if v.Regs != nil {
+		if v.Regs.GDPR != nil || v.Regs.USPrivacy != "" {
+			if r.Regs.Ext == nil {
+				r.Regs.Ext = new(RegsExt)
+			}
+			if v.Regs.GDPR != nil {
+				r.Regs.Ext.GDPR = *v.Regs.GDPR
+			}
+			if v.Regs.USPrivacy != "" {
+				r.Regs.Ext.USPrivacy = v.Regs.USPrivacy
+			}
+		}
+	}
+	if v.User != nil {
+		if v.User.Consent != "" || v.User.EIDs != nil {
+			if r.User.Ext == nil {
+				r.User.Ext = new(UserExt)
+			}
+			if v.User.Consent != "" {
+				r.User.Ext.Consent = v.User.Consent
+			}
+			if v.User.EIDs != nil {
+				l.setExt("user.eids", &r.User.Ext.Extensions, "eids", v.User.EIDs)
+			}
+		}
+	}
+	if v.Source != nil && v.Source.SChain != nil {
+		if r.Source.Ext == nil {
+			r.Source.Ext = new(SourceExt)
+		}
+		l.setExt("source.schain", &r.Source.Ext.Extensions, "schain", v.Source.SChain)
+	}
+	for i := range v.Imp {
+		imp, path := &r.Imp[i], "imp["+strconv.Itoa(i)+"]"
+		if v.Imp[i].Rwdd != 0 {
+			if imp.Ext == nil {
+				imp.Ext = new(ImpExt)
+			}
+			l.setExt(path+".rwdd", &imp.Ext.Extensions, "is_rewarded_inventory", v.Imp[i].Rwdd)
+		}
+		if video := v.Imp[i].Video; video != nil && video.Plcmt != 0 {
+			if imp.Video.Ext == nil {
+				imp.Video.Ext = new(VideoExt)
+			}
+			l.setExt(path+".video.plcmt", &imp.Video.Ext.Extensions, "plcmt", video.Plcmt)
+		}
+	}
+	return r, l.losses
+}
+
+// conversion collects the losses of a conversion, the methods generated into convert_gen.go report to it
+type conversion struct {
+	losses []Loss
+}
+
+func (l *conversion) lose(path, reason string) {
+	l.losses = append(l.losses, Loss{Path: strings.TrimPrefix(path, "."), Reason: reason})
+}
+
+// parseFloat returns the number s is, 0 and a loss if it isn't one
+func (l *conversion) parseFloat(path, s string) float64 {
+	if s == "" {
+		return 0
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		l.lose(path, "not a number")
+		return 0
+	}
+	return f
+}
+
+// marshalExt encodes an ext, an empty one as nil
+func (l *conversion) marshalExt(path string, ext json.Marshaler) json.RawMessage {
+	b, err := ext.MarshalJSON()
+	if err != nil {
+		l.lose(path, err.Error())
+		return nil
+	}
+	if bytes.Equal(b, []byte("{}")) {
+		return nil
+	}
+	return b
+}
+
+func (l *conversion) unmarshalExt(path string, raw json.RawMessage, ext json.Unmarshaler) {
+	if err := ext.UnmarshalJSON(raw); err != nil {
+		l.lose(path, err.Error())
+	}
+}
+
+// takeExt decodes the key of an encoded ext into v and removes it from the ext, reporting if it did. A value
+// that doesn't decode into v is left in the ext, and reported
+func (l *conversion) takeExt(path string, ext *json.RawMessage, key string, v interface{}) bool {
+	var keys map[string]json.RawMessage
+	if len(*ext) == 0 || json.Unmarshal(*ext, &keys) != nil {
+		return false
+	}
+	raw, ok := keys[key]
+	if !ok {
+		return false
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		l.lose(path+"."+key, "invalid, left in ext")
+		return false
+	}
+
+	delete(keys, key)
+	if len(keys) == 0 {
+		*ext = nil
+		return true
+	}
+	b, err := json.Marshal(keys)
+	if err != nil {
+		l.lose(path, err.Error())
+		return true
+	}
+	*ext = b
+	return true
+}
+
+// putExt sets the key of an encoded ext to v
+func (l *conversion) putExt(path string, ext *json.RawMessage, key string, v interface{}) {
+	keys := make(map[string]json.RawMessage)
+	if len(*ext) > 0 {
+		if err := json.Unmarshal(*ext, &keys); err != nil {
+			l.lose(path+"."+key, err.Error())
+			return
+		}
+	}
+	raw, err := json.Marshal(v)
+	if err == nil {
+		keys[key] = raw
+		raw, err = json.Marshal(keys)
+	}
+	if err != nil {
+		l.lose(path+"."+key, err.Error())
+		return
+	}
+	*ext = raw
+}
+
+func (l *conversion) setExt(path string, ext *Extensions, key string, v interface{}) {
+	if err := ext.SetExt(key, v); err != nil {
+		l.lose(path, err.Error())
+	}
+}
+
+// isZero reports if v is the zero value of its type
+func isZero(v interface{}) bool {
+	return reflect.ValueOf(v).IsZero()
+}
@@ -0,0 +1,165 @@
+package twofive
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/timehop/ortb-twofive/twosix"
+)
+
+// roundTripV26 converts the request to 2.6 and back through JSON, as a partner speaking 2.6 would see it
+func roundTripV26(t *testing.T, req *Request) (*twosix.BidRequest, *Request, []Loss) {
+	t.Helper()
+	v, losses := ToV26(req)
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded twosix.BidRequest
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	back, fromLosses := FromV26(&decoded)
+	if len(fromLosses) > 0 {
+		t.Errorf("FromV26() losses = %v", fromLosses)
+	}
+	return v, back, losses
+}
+
+func TestConvertRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		losses []Loss
+	}{
+		{name: "static", file: "./test_data/static_bid_request.json"},
+		{name: "video", file: "./test_data/video_bid_request.json"},
+		{name: "native", file: "./test_data/native_bid_request.json"},
+		{name: "site", file: "./test_data/site_bid_request.json", losses: []Loss{{Path: "site.amp", Reason: "no 2.6 field"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := loadValidRequest(t, tt.file)
+			_, back, losses := roundTripV26(t, req)
+			if !reflect.DeepEqual(losses, tt.losses) {
+				t.Fatalf("ToV26() losses = %v, want %v", losses, tt.losses)
+			}
+			if err := back.Validate(); err != nil {
+				t.Errorf("Validate() = %v", err)
+			}
+			if tt.losses != nil {
+				return
+			}
+			got, _ := json.Marshal(back)
+			want, _ := json.Marshal(req)
+			if string(got) != string(want) {
+				t.Errorf("got  %s\nwant %s", got, want)
+			}
+		})
+	}
+}
+
+func TestToV26Ext(t *testing.T) {
+	req := loadValidRequest(t, "./test_data/video_bid_request.json")
+	req.Regs.Ext = &RegsExt{GDPR: 1, USPrivacy: "1YNN"}
+	req.Regs.Ext.SetExt("dsa", map[string]int{"required": 1})
+	req.User.Ext.SetExt("eids", []twosix.EID{{Source: "id5-sync.com", UIDs: []twosix.UID{{ID: "ID5*abc", AType: 1}}}})
+	req.Source = &Source{TID: "42", Ext: &SourceExt{Omidpn: "om"}}
+	req.Source.Ext.SetExt("schain", twosix.SupplyChain{Complete: 1, Ver: "1.0", Nodes: []twosix.SupplyChainNode{{ASI: "a.com", SID: "1", HP: 1}}})
+	req.Imp[0].Ext = &ImpExt{}
+	req.Imp[0].Ext.SetExt("is_rewarded_inventory", 1)
+	req.Imp[0].Video.Ext = &VideoExt{}
+	req.Imp[0].Video.Ext.SetExt("plcmt", 2)
+
+	v, back, losses := roundTripV26(t, req)
+	if losses != nil {
+		t.Fatalf("ToV26() losses = %v", losses)
+	}
+	if v.Regs.GDPR == nil || *v.Regs.GDPR != 1 || v.Regs.USPrivacy != "1YNN" || string(v.Regs.Ext) != `{"dsa":{"required":1}}` {
+		t.Errorf("regs = %+v", v.Regs)
+	}
+	if v.User.Consent != req.User.Ext.Consent || len(v.User.EIDs) != 1 || v.User.EIDs[0].UIDs[0].AType != 1 || v.User.Ext != nil {
+		t.Errorf("user = %+v", v.User)
+	}
+	if v.Source.TID != "42" || v.Source.SChain == nil || v.Source.SChain.Nodes[0].ASI != "a.com" || string(v.Source.Ext) != `{"omidpn":"om"}` {
+		t.Errorf("source = %+v", v.Source)
+	}
+	if v.Imp[0].Rwdd != 1 || v.Imp[0].Ext != nil || v.Imp[0].Video.Plcmt != 2 || v.Imp[0].Video.Ext != nil {
+		t.Errorf("imp = %+v", v.Imp[0])
+	}
+
+	got, _ := json.Marshal(back)
+	want, _ := json.Marshal(req)
+	if string(got) != string(want) {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestConvertLosses(t *testing.T) {
+	to := []struct {
+		name   string
+		mutate func(r *Request)
+		want   []Loss
+	}{
+		{name: "age", mutate: func(r *Request) { r.User.Age = 30 }, want: []Loss{{Path: "user.age", Reason: "no 2.6 field"}}},
+		{
+			name:   "invalid ext",
+			mutate: func(r *Request) { r.User.Ext.SetExt("eids", "id5") },
+			want:   []Loss{{Path: "user.ext.eids", Reason: "invalid, left in ext"}},
+		},
+		{
+			name:   "deal currency",
+			mutate: func(r *Request) { r.Imp[0].PMP = &PMP{Deals: []Deal{{ID: "d", BidFloorCur: 1.5}}} },
+			want:   []Loss{{Path: "imp[0].pmp.deals[0].bidfloorcur", Reason: "no 2.6 field"}},
+		},
+	}
+	for _, tt := range to {
+		t.Run("ToV26 "+tt.name, func(t *testing.T) {
+			req := loadValidRequest(t, "./test_data/static_bid_request.json")
+			tt.mutate(req)
+			if _, losses := ToV26(req); !reflect.DeepEqual(losses, tt.want) {
+				t.Errorf("losses = %v, want %v", losses, tt.want)
+			}
+		})
+	}
+
+	from := []struct {
+		name   string
+		mutate func(v *twosix.BidRequest)
+		want   []Loss
+	}{
+		{name: "wlangb", mutate: func(v *twosix.BidRequest) { v.WLangB = []string{"en"} }, want: []Loss{{Path: "wlangb", Reason: "no 2.5 field"}}},
+		{name: "ssai", mutate: func(v *twosix.BidRequest) { v.Imp[0].SSAI = 1 }, want: []Loss{{Path: "imp[0].ssai", Reason: "no 2.5 field"}}},
+	}
+	for _, tt := range from {
+		t.Run("FromV26 "+tt.name, func(t *testing.T) {
+			v, _ := ToV26(loadValidRequest(t, "./test_data/static_bid_request.json"))
+			tt.mutate(v)
+			if _, losses := FromV26(v); !reflect.DeepEqual(losses, tt.want) {
+				t.Errorf("losses = %v, want %v", losses, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromV26NoExt(t *testing.T) {
+	v := &twosix.BidRequest{ID: "1", Imp: []twosix.Imp{{ID: "1", Banner: &twosix.Banner{W: 300, H: 250}}}}
+	r, losses := FromV26(v)
+	if losses != nil {
+		t.Errorf("losses = %v", losses)
+	}
+	if !isZero(r.Format) {
+		t.Errorf("format = %+v, want none as the request has none", r.Format)
+	}
+}
+
+func TestConvertNil(t *testing.T) {
+	if v, losses := ToV26(nil); v != nil || losses != nil {
+		t.Errorf("ToV26(nil) = %v, %v", v, losses)
+	}
+	if r, losses := FromV26(nil); r != nil || losses != nil {
+		t.Errorf("FromV26(nil) = %v, %v", r, losses)
+	}
+}
@@ -0,0 +1,878 @@
+package twofive
+
+import "strconv"
+
+// The enumerated lists of section 5 of the spec. Each list is a named int type, so they marshal to and from
+// JSON as the plain integers the spec defines. 5.1 Content Categories are left as strings, as are the fields
+// that use them
+
+// BannerAdType is the type of banner ad, refer to list 5.2
+type BannerAdType int
+
+// 5.2 Banner Ad Types
+const (
+	BannerTypeXHTMLText   BannerAdType = 1
+	BannerTypeXHTMLBanner BannerAdType = 2
+	BannerTypeJavaScript  BannerAdType = 3
+	BannerTypeIframe      BannerAdType = 4
+)
+
+var bannerAdTypeNames = map[BannerAdType]string{
+	BannerTypeXHTMLText:   "XHTML Text Ad",
+	BannerTypeXHTMLBanner: "XHTML Banner Ad",
+	BannerTypeJavaScript:  "JavaScript Ad",
+	BannerTypeIframe:      "iframe",
+}
+
+func (b BannerAdType) String() string {
+	if s, ok := bannerAdTypeNames[b]; ok {
+		return s
+	}
+	return "BannerAdType(" + strconv.Itoa(int(b)) + ")"
+}
+
+// IsValid reports if b is one of the values defined by list 5.2
+func (b BannerAdType) IsValid() bool {
+	_, ok := bannerAdTypeNames[b]
+	return ok
+}
+
+// CreativeAttribute is an attribute describing the ad creative, refer to list 5.3
+type CreativeAttribute int
+
+// 5.3 Creative Attributes
+const (
+	AttrAudioAutoPlay              CreativeAttribute = 1
+	AttrAudioUserInitiated         CreativeAttribute = 2
+	AttrExpandableAutomatic        CreativeAttribute = 3
+	AttrExpandableClick            CreativeAttribute = 4
+	AttrExpandableRollover         CreativeAttribute = 5
+	AttrInBannerVideoAutoPlay      CreativeAttribute = 6
+	AttrInBannerVideoUserInitiated CreativeAttribute = 7
+	AttrPop                        CreativeAttribute = 8
+	AttrProvocativeImagery         CreativeAttribute = 9
+	AttrExtremeAnimation           CreativeAttribute = 10
+	AttrSurveys                    CreativeAttribute = 11
+	AttrTextOnly                   CreativeAttribute = 12
+	AttrUserInteractive            CreativeAttribute = 13
+	AttrWindowsDialog              CreativeAttribute = 14
+	AttrAudioOnOffButton           CreativeAttribute = 15
+	AttrSkipButton                 CreativeAttribute = 16
+	AttrAdobeFlash                 CreativeAttribute = 17
+)
+
+var creativeAttributeNames = map[CreativeAttribute]string{
+	AttrAudioAutoPlay:              "Audio Ad (Auto-Play)",
+	AttrAudioUserInitiated:         "Audio Ad (User Initiated)",
+	AttrExpandableAutomatic:        "Expandable (Automatic)",
+	AttrExpandableClick:            "Expandable (User Initiated - Click)",
+	AttrExpandableRollover:         "Expandable (User Initiated - Rollover)",
+	AttrInBannerVideoAutoPlay:      "In-Banner Video Ad (Auto-Play)",
+	AttrInBannerVideoUserInitiated: "In-Banner Video Ad (User Initiated)",
+	AttrPop:                        "Pop (e.g., Over, Under, or Upon Exit)",
+	AttrProvocativeImagery:         "Provocative or Suggestive Imagery",
+	AttrExtremeAnimation:           "Shaky, Flashing, Flickering, Extreme Animation, Smileys",
+	AttrSurveys:                    "Surveys",
+	AttrTextOnly:                   "Text Only",
+	AttrUserInteractive:            "User Interactive (e.g., Embedded Games)",
+	AttrWindowsDialog:              "Windows Dialog or Alert Style",
+	AttrAudioOnOffButton:           "Has Audio On/Off Button",
+	AttrSkipButton:                 "Ad Provides Skip Button (e.g. VPAID-rendered skip button on pre-roll video)",
+	AttrAdobeFlash:                 "Adobe Flash",
+}
+
+func (c CreativeAttribute) String() string {
+	if s, ok := creativeAttributeNames[c]; ok {
+		return s
+	}
+	return "CreativeAttribute(" + strconv.Itoa(int(c)) + ")"
+}
+
+// IsValid reports if c is one of the values defined by list 5.3
+func (c CreativeAttribute) IsValid() bool {
+	_, ok := creativeAttributeNames[c]
+	return ok
+}
+
+// AdPosition is the position of the ad as a relative measure of visibility or prominence, refer to list 5.4
+type AdPosition int
+
+// 5.4 Ad Position
+const (
+	PositionUnknown      AdPosition = 0
+	PositionAboveTheFold AdPosition = 1
+	PositionDeprecated   AdPosition = 2
+	PositionBelowTheFold AdPosition = 3
+	PositionHeader       AdPosition = 4
+	PositionFooter       AdPosition = 5
+	PositionSidebar      AdPosition = 6
+	PositionFullScreen   AdPosition = 7
+)
+
+var adPositionNames = map[AdPosition]string{
+	PositionUnknown:      "Unknown",
+	PositionAboveTheFold: "Above the Fold",
+	PositionDeprecated:   "DEPRECATED - May or may not be initially visible depending on screen size/resolution.",
+	PositionBelowTheFold: "Below the Fold",
+	PositionHeader:       "Header",
+	PositionFooter:       "Footer",
+	PositionSidebar:      "Sidebar",
+	PositionFullScreen:   "Full Screen",
+}
+
+func (a AdPosition) String() string {
+	if s, ok := adPositionNames[a]; ok {
+		return s
+	}
+	return "AdPosition(" + strconv.Itoa(int(a)) + ")"
+}
+
+// IsValid reports if a is one of the values defined by list 5.4
+func (a AdPosition) IsValid() bool {
+	_, ok := adPositionNames[a]
+	return ok
+}
+
+// ExpandableDirection is a direction in which an expandable ad may expand, refer to list 5.5
+type ExpandableDirection int
+
+// 5.5 Expandable Direction
+const (
+	ExpandLeft       ExpandableDirection = 1
+	ExpandRight      ExpandableDirection = 2
+	ExpandUp         ExpandableDirection = 3
+	ExpandDown       ExpandableDirection = 4
+	ExpandFullScreen ExpandableDirection = 5
+)
+
+var expandableDirectionNames = map[ExpandableDirection]string{
+	ExpandLeft:       "Left",
+	ExpandRight:      "Right",
+	ExpandUp:         "Up",
+	ExpandDown:       "Down",
+	ExpandFullScreen: "Full Screen",
+}
+
+func (e ExpandableDirection) String() string {
+	if s, ok := expandableDirectionNames[e]; ok {
+		return s
+	}
+	return "ExpandableDirection(" + strconv.Itoa(int(e)) + ")"
+}
+
+// IsValid reports if e is one of the values defined by list 5.5
+func (e ExpandableDirection) IsValid() bool {
+	_, ok := expandableDirectionNames[e]
+	return ok
+}
+
+// APIFramework is an API framework supported by the placement or required by the ad, refer to list 5.6
+type APIFramework int
+
+// 5.6 API Frameworks
+const (
+	APIVPAID1 APIFramework = 1
+	APIVPAID2 APIFramework = 2
+	APIMRAID1 APIFramework = 3
+	APIORMMA  APIFramework = 4
+	APIMRAID2 APIFramework = 5
+	APIMRAID3 APIFramework = 6
+)
+
+var apiFrameworkNames = map[APIFramework]string{
+	APIVPAID1: "VPAID 1.0",
+	APIVPAID2: "VPAID 2.0",
+	APIMRAID1: "MRAID-1",
+	APIORMMA:  "ORMMA",
+	APIMRAID2: "MRAID-2",
+	APIMRAID3: "MRAID-3",
+}
+
+func (a APIFramework) String() string {
+	if s, ok := apiFrameworkNames[a]; ok {
+		return s
+	}
+	return "APIFramework(" + strconv.Itoa(int(a)) + ")"
+}
+
+// IsValid reports if a is one of the values defined by list 5.6
+func (a APIFramework) IsValid() bool {
+	_, ok := apiFrameworkNames[a]
+	return ok
+}
+
+// VideoLinearity is the linearity of a video ad, refer to list 5.7
+type VideoLinearity int
+
+// 5.7 Video Linearity
+const (
+	LinearityLinear    VideoLinearity = 1
+	LinearityNonLinear VideoLinearity = 2
+)
+
+var videoLinearityNames = map[VideoLinearity]string{
+	LinearityLinear:    "Linear / In-Stream",
+	LinearityNonLinear: "Non-Linear / Overlay",
+}
+
+func (v VideoLinearity) String() string {
+	if s, ok := videoLinearityNames[v]; ok {
+		return s
+	}
+	return "VideoLinearity(" + strconv.Itoa(int(v)) + ")"
+}
+
+// IsValid reports if v is one of the values defined by list 5.7
+func (v VideoLinearity) IsValid() bool {
+	_, ok := videoLinearityNames[v]
+	return ok
+}
+
+// Protocol is a video or audio bid response protocol, refer to list 5.8
+type Protocol int
+
+// 5.8 Protocols
+const (
+	ProtocolVAST1         Protocol = 1
+	ProtocolVAST2         Protocol = 2
+	ProtocolVAST3         Protocol = 3
+	ProtocolVAST1Wrapper  Protocol = 4
+	ProtocolVAST2Wrapper  Protocol = 5
+	ProtocolVAST3Wrapper  Protocol = 6
+	ProtocolVAST4         Protocol = 7
+	ProtocolVAST4Wrapper  Protocol = 8
+	ProtocolDAAST1        Protocol = 9
+	ProtocolDAAST1Wrapper Protocol = 10
+)
+
+var protocolNames = map[Protocol]string{
+	ProtocolVAST1:         "VAST 1.0",
+	ProtocolVAST2:         "VAST 2.0",
+	ProtocolVAST3:         "VAST 3.0",
+	ProtocolVAST1Wrapper:  "VAST 1.0 Wrapper",
+	ProtocolVAST2Wrapper:  "VAST 2.0 Wrapper",
+	ProtocolVAST3Wrapper:  "VAST 3.0 Wrapper",
+	ProtocolVAST4:         "VAST 4.0",
+	ProtocolVAST4Wrapper:  "VAST 4.0 Wrapper",
+	ProtocolDAAST1:        "DAAST 1.0",
+	ProtocolDAAST1Wrapper: "DAAST 1.0 Wrapper",
+}
+
+func (p Protocol) String() string {
+	if s, ok := protocolNames[p]; ok {
+		return s
+	}
+	return "Protocol(" + strconv.Itoa(int(p)) + ")"
+}
+
+// IsValid reports if p is one of the values defined by list 5.8
+func (p Protocol) IsValid() bool {
+	_, ok := protocolNames[p]
+	return ok
+}
+
+// VideoPlacement is the type of video placement, refer to list 5.9
+type VideoPlacement int
+
+// 5.9 Video Placement Types
+const (
+	PlacementInStream     VideoPlacement = 1
+	PlacementInBanner     VideoPlacement = 2
+	PlacementInArticle    VideoPlacement = 3
+	PlacementInFeed       VideoPlacement = 4
+	PlacementInterstitial VideoPlacement = 5
+)
+
+var videoPlacementNames = map[VideoPlacement]string{
+	PlacementInStream:     "In-Stream",
+	PlacementInBanner:     "In-Banner",
+	PlacementInArticle:    "In-Article",
+	PlacementInFeed:       "In-Feed",
+	PlacementInterstitial: "Interstitial/Slider/Floating",
+}
+
+func (v VideoPlacement) String() string {
+	if s, ok := videoPlacementNames[v]; ok {
+		return s
+	}
+	return "VideoPlacement(" + strconv.Itoa(int(v)) + ")"
+}
+
+// IsValid reports if v is one of the values defined by list 5.9
+func (v VideoPlacement) IsValid() bool {
+	_, ok := videoPlacementNames[v]
+	return ok
+}
+
+// PlaybackMethod is a video playback method, refer to list 5.10
+type PlaybackMethod int
+
+// 5.10 Playback Methods
+const (
+	PlaybackPageLoadSoundOn  PlaybackMethod = 1
+	PlaybackPageLoadSoundOff PlaybackMethod = 2
+	PlaybackClickSoundOn     PlaybackMethod = 3
+	PlaybackMouseOverSoundOn PlaybackMethod = 4
+	PlaybackViewportSoundOn  PlaybackMethod = 5
+	PlaybackViewportSoundOff PlaybackMethod = 6
+)
+
+var playbackMethodNames = map[PlaybackMethod]string{
+	PlaybackPageLoadSoundOn:  "Initiates on Page Load with Sound On",
+	PlaybackPageLoadSoundOff: "Initiates on Page Load with Sound Off by Default",
+	PlaybackClickSoundOn:     "Initiates on Click with Sound On",
+	PlaybackMouseOverSoundOn: "Initiates on Mouse-Over with Sound On",
+	PlaybackViewportSoundOn:  "Initiates on Entering Viewport with Sound On",
+	PlaybackViewportSoundOff: "Initiates on Entering Viewport with Sound Off by Default",
+}
+
+func (p PlaybackMethod) String() string {
+	if s, ok := playbackMethodNames[p]; ok {
+		return s
+	}
+	return "PlaybackMethod(" + strconv.Itoa(int(p)) + ")"
+}
+
+// IsValid reports if p is one of the values defined by list 5.10
+func (p PlaybackMethod) IsValid() bool {
+	_, ok := playbackMethodNames[p]
+	return ok
+}
+
+// PlaybackCessationMode is the event that causes video playback to end, refer to list 5.11
+type PlaybackCessationMode int
+
+// 5.11 Playback Cessation Modes
+const (
+	PlaybackEndVideoCompletion         PlaybackCessationMode = 1
+	PlaybackEndLeavingViewport         PlaybackCessationMode = 2
+	PlaybackEndFloatingUntilCompletion PlaybackCessationMode = 3
+)
+
+var playbackCessationModeNames = map[PlaybackCessationMode]string{
+	PlaybackEndVideoCompletion:         "On Video Completion or when Terminated by User",
+	PlaybackEndLeavingViewport:         "On Leaving Viewport or when Terminated by User",
+	PlaybackEndFloatingUntilCompletion: "On Leaving Viewport Continues as a Floating/Slider Unit until Video Completion or when Terminated by User",
+}
+
+func (p PlaybackCessationMode) String() string {
+	if s, ok := playbackCessationModeNames[p]; ok {
+		return s
+	}
+	return "PlaybackCessationMode(" + strconv.Itoa(int(p)) + ")"
+}
+
+// IsValid reports if p is one of the values defined by list 5.11
+func (p PlaybackCessationMode) IsValid() bool {
+	_, ok := playbackCessationModeNames[p]
+	return ok
+}
+
+// StartDelay is the start delay in seconds for pre-roll, mid-roll, or post-roll ad placements, refer to list
+// 5.12. Values greater than 0 are a mid-roll with the value being the start delay
+type StartDelay int
+
+// 5.12 Start Delay
+const (
+	StartDelayPreRoll         StartDelay = 0
+	StartDelayGenericMidRoll  StartDelay = -1
+	StartDelayGenericPostRoll StartDelay = -2
+)
+
+func (s StartDelay) String() string {
+	switch {
+	case s == StartDelayPreRoll:
+		return "Pre-Roll"
+	case s == StartDelayGenericMidRoll:
+		return "Generic Mid-Roll"
+	case s == StartDelayGenericPostRoll:
+		return "Generic Post-Roll"
+	case s > 0:
+		return "Mid-Roll " + strconv.Itoa(int(s)) + "s"
+	}
+	return "StartDelay(" + strconv.Itoa(int(s)) + ")"
+}
+
+// IsValid reports if s is one of the values defined by list 5.12
+func (s StartDelay) IsValid() bool {
+	return s >= StartDelayGenericPostRoll
+}
+
+// ProductionQuality is the production quality of the content, refer to list 5.13
+type ProductionQuality int
+
+// 5.13 Production Quality
+const (
+	QualityUnknown       ProductionQuality = 0
+	QualityProfessional  ProductionQuality = 1
+	QualityProsumer      ProductionQuality = 2
+	QualityUserGenerated ProductionQuality = 3
+)
+
+var productionQualityNames = map[ProductionQuality]string{
+	QualityUnknown:       "Unknown",
+	QualityProfessional:  "Professionally Produced",
+	QualityProsumer:      "Prosumer",
+	QualityUserGenerated: "User Generated (UGC)",
+}
+
+func (p ProductionQuality) String() string {
+	if s, ok := productionQualityNames[p]; ok {
+		return s
+	}
+	return "ProductionQuality(" + strconv.Itoa(int(p)) + ")"
+}
+
+// IsValid reports if p is one of the values defined by list 5.13
+func (p ProductionQuality) IsValid() bool {
+	_, ok := productionQualityNames[p]
+	return ok
+}
+
+// CompanionType is a type of companion ad, refer to list 5.14
+type CompanionType int
+
+// 5.14 Companion Types
+const (
+	CompanionStatic CompanionType = 1
+	CompanionHTML   CompanionType = 2
+	CompanionIframe CompanionType = 3
+)
+
+var companionTypeNames = map[CompanionType]string{
+	CompanionStatic: "Static Resource",
+	CompanionHTML:   "HTML Resource",
+	CompanionIframe: "iframe Resource",
+}
+
+func (c CompanionType) String() string {
+	if s, ok := companionTypeNames[c]; ok {
+		return s
+	}
+	return "CompanionType(" + strconv.Itoa(int(c)) + ")"
+}
+
+// IsValid reports if c is one of the values defined by list 5.14
+func (c CompanionType) IsValid() bool {
+	_, ok := companionTypeNames[c]
+	return ok
+}
+
+// ContentDelivery is a method of delivering the content, refer to list 5.15
+type ContentDelivery int
+
+// 5.15 Content Delivery Methods
+const (
+	DeliveryStreaming   ContentDelivery = 1
+	DeliveryProgressive ContentDelivery = 2
+	DeliveryDownload    ContentDelivery = 3
+)
+
+var contentDeliveryNames = map[ContentDelivery]string{
+	DeliveryStreaming:   "Streaming",
+	DeliveryProgressive: "Progressive",
+	DeliveryDownload:    "Download",
+}
+
+func (c ContentDelivery) String() string {
+	if s, ok := contentDeliveryNames[c]; ok {
+		return s
+	}
+	return "ContentDelivery(" + strconv.Itoa(int(c)) + ")"
+}
+
+// IsValid reports if c is one of the values defined by list 5.15
+func (c ContentDelivery) IsValid() bool {
+	_, ok := contentDeliveryNames[c]
+	return ok
+}
+
+// FeedType is the type of audio feed, refer to list 5.16
+type FeedType int
+
+// 5.16 Feed Types
+const (
+	FeedMusicService FeedType = 1
+	FeedBroadcast    FeedType = 2
+	FeedPodcast      FeedType = 3
+)
+
+var feedTypeNames = map[FeedType]string{
+	FeedMusicService: "Music Service",
+	FeedBroadcast:    "FM/AM Broadcast",
+	FeedPodcast:      "Podcast",
+}
+
+func (f FeedType) String() string {
+	if s, ok := feedTypeNames[f]; ok {
+		return s
+	}
+	return "FeedType(" + strconv.Itoa(int(f)) + ")"
+}
+
+// IsValid reports if f is one of the values defined by list 5.16
+func (f FeedType) IsValid() bool {
+	_, ok := feedTypeNames[f]
+	return ok
+}
+
+// VolumeNormalization is the volume normalization mode of an audio ad, refer to list 5.17
+type VolumeNormalization int
+
+// 5.17 Volume Normalization Modes
+const (
+	VolumeNone               VolumeNormalization = 0
+	VolumeAverageNormalized  VolumeNormalization = 1
+	VolumePeakNormalized     VolumeNormalization = 2
+	VolumeLoudnessNormalized VolumeNormalization = 3
+	VolumeCustom             VolumeNormalization = 4
+)
+
+var volumeNormalizationNames = map[VolumeNormalization]string{
+	VolumeNone:               "None",
+	VolumeAverageNormalized:  "Ad Volume Average Normalized to Content",
+	VolumePeakNormalized:     "Ad Volume Peak Normalized to Content",
+	VolumeLoudnessNormalized: "Ad Loudness Normalized to Content",
+	VolumeCustom:             "Custom Volume Normalization",
+}
+
+func (v VolumeNormalization) String() string {
+	if s, ok := volumeNormalizationNames[v]; ok {
+		return s
+	}
+	return "VolumeNormalization(" + strconv.Itoa(int(v)) + ")"
+}
+
+// IsValid reports if v is one of the values defined by list 5.17
+func (v VolumeNormalization) IsValid() bool {
+	_, ok := volumeNormalizationNames[v]
+	return ok
+}
+
+// ContentContext is the type of content, refer to list 5.18
+type ContentContext int
+
+// 5.18 Content Context
+const (
+	ContextVideo       ContentContext = 1
+	ContextGame        ContentContext = 2
+	ContextMusic       ContentContext = 3
+	ContextApplication ContentContext = 4
+	ContextText        ContentContext = 5
+	ContextOther       ContentContext = 6
+	ContextUnknown     ContentContext = 7
+)
+
+var contentContextNames = map[ContentContext]string{
+	ContextVideo:       "Video",
+	ContextGame:        "Game",
+	ContextMusic:       "Music",
+	ContextApplication: "Application",
+	ContextText:        "Text",
+	ContextOther:       "Other",
+	ContextUnknown:     "Unknown",
+}
+
+func (c ContentContext) String() string {
+	if s, ok := contentContextNames[c]; ok {
+		return s
+	}
+	return "ContentContext(" + strconv.Itoa(int(c)) + ")"
+}
+
+// IsValid reports if c is one of the values defined by list 5.18
+func (c ContentContext) IsValid() bool {
+	_, ok := contentContextNames[c]
+	return ok
+}
+
+// QAGMediaRating is the IQG media rating of the content, refer to list 5.19
+type QAGMediaRating int
+
+// 5.19 IQG Media Ratings
+const (
+	MediaRatingAllAudiences   QAGMediaRating = 1
+	MediaRatingEveryoneOver12 QAGMediaRating = 2
+	MediaRatingMature         QAGMediaRating = 3
+)
+
+var qagMediaRatingNames = map[QAGMediaRating]string{
+	MediaRatingAllAudiences:   "All Audiences",
+	MediaRatingEveryoneOver12: "Everyone Over 12",
+	MediaRatingMature:         "Mature Audiences",
+}
+
+func (q QAGMediaRating) String() string {
+	if s, ok := qagMediaRatingNames[q]; ok {
+		return s
+	}
+	return "QAGMediaRating(" + strconv.Itoa(int(q)) + ")"
+}
+
+// IsValid reports if q is one of the values defined by list 5.19
+func (q QAGMediaRating) IsValid() bool {
+	_, ok := qagMediaRatingNames[q]
+	return ok
+}
+
+// LocationType is the source of the location data, refer to list 5.20
+type LocationType int
+
+// 5.20 Location Type
+const (
+	LocationGPS          LocationType = 1
+	LocationIP           LocationType = 2
+	LocationUserProvided LocationType = 3
+)
+
+var locationTypeNames = map[LocationType]string{
+	LocationGPS:          "GPS/Location Services",
+	LocationIP:           "IP Address",
+	LocationUserProvided: "User provided (e.g., registration data)",
+}
+
+func (l LocationType) String() string {
+	if s, ok := locationTypeNames[l]; ok {
+		return s
+	}
+	return "LocationType(" + strconv.Itoa(int(l)) + ")"
+}
+
+// IsValid reports if l is one of the values defined by list 5.20
+func (l LocationType) IsValid() bool {
+	_, ok := locationTypeNames[l]
+	return ok
+}
+
+// DeviceType is the type of device, refer to list 5.21
+type DeviceType int
+
+// 5.21 Device Type
+const (
+	DeviceMobileTablet     DeviceType = 1
+	DevicePersonalComputer DeviceType = 2
+	DeviceConnectedTV      DeviceType = 3
+	DevicePhone            DeviceType = 4
+	DeviceTablet           DeviceType = 5
+	DeviceConnectedDevice  DeviceType = 6
+	DeviceSetTopBox        DeviceType = 7
+)
+
+var deviceTypeNames = map[DeviceType]string{
+	DeviceMobileTablet:     "Mobile/Tablet",
+	DevicePersonalComputer: "Personal Computer",
+	DeviceConnectedTV:      "Connected TV",
+	DevicePhone:            "Phone",
+	DeviceTablet:           "Tablet",
+	DeviceConnectedDevice:  "Connected Device",
+	DeviceSetTopBox:        "Set Top Box",
+}
+
+func (d DeviceType) String() string {
+	if s, ok := deviceTypeNames[d]; ok {
+		return s
+	}
+	return "DeviceType(" + strconv.Itoa(int(d)) + ")"
+}
+
+// IsValid reports if d is one of the values defined by list 5.21
+func (d DeviceType) IsValid() bool {
+	_, ok := deviceTypeNames[d]
+	return ok
+}
+
+// ConnectionType is the type of network connection, refer to list 5.22
+type ConnectionType int
+
+// 5.22 Connection Type
+const (
+	ConnectionUnknown         ConnectionType = 0
+	ConnectionEthernet        ConnectionType = 1
+	ConnectionWIFI            ConnectionType = 2
+	ConnectionCellularUnknown ConnectionType = 3
+	ConnectionCellular2G      ConnectionType = 4
+	ConnectionCellular3G      ConnectionType = 5
+	ConnectionCellular4G      ConnectionType = 6
+)
+
+var connectionTypeNames = map[ConnectionType]string{
+	ConnectionUnknown:         "Unknown",
+	ConnectionEthernet:        "Ethernet",
+	ConnectionWIFI:            "WIFI",
+	ConnectionCellularUnknown: "Cellular Network - Unknown Generation",
+	ConnectionCellular2G:      "Cellular Network - 2G",
+	ConnectionCellular3G:      "Cellular Network - 3G",
+	ConnectionCellular4G:      "Cellular Network - 4G",
+}
+
+func (c ConnectionType) String() string {
+	if s, ok := connectionTypeNames[c]; ok {
+		return s
+	}
+	return "ConnectionType(" + strconv.Itoa(int(c)) + ")"
+}
+
+// IsValid reports if c is one of the values defined by list 5.22
+func (c ConnectionType) IsValid() bool {
+	_, ok := connectionTypeNames[c]
+	return ok
+}
+
+// IPLocationService is the service or provider used to determine geolocation from IP address, refer to list 5.23
+type IPLocationService int
+
+// 5.23 IP Location Services
+const (
+	IPServiceIP2Location IPLocationService = 1
+	IPServiceNeustar     IPLocationService = 2
+	IPServiceMaxMind     IPLocationService = 3
+	IPServiceNetAcuity   IPLocationService = 4
+)
+
+var ipLocationServiceNames = map[IPLocationService]string{
+	IPServiceIP2Location: "ip2location",
+	IPServiceNeustar:     "Neustar (Quova)",
+	IPServiceMaxMind:     "MaxMind",
+	IPServiceNetAcuity:   "NetAcuity (Digital Element)",
+}
+
+func (i IPLocationService) String() string {
+	if s, ok := ipLocationServiceNames[i]; ok {
+		return s
+	}
+	return "IPLocationService(" + strconv.Itoa(int(i)) + ")"
+}
+
+// IsValid reports if i is one of the values defined by list 5.23
+func (i IPLocationService) IsValid() bool {
+	_, ok := ipLocationServiceNames[i]
+	return ok
+}
+
+// NoBidReason is the reason for not bidding, refer to list 5.24
+type NoBidReason int
+
+// 5.24 No-Bid Reason Codes
+const (
+	NoBidUnknownError             NoBidReason = 0
+	NoBidTechnicalError           NoBidReason = 1
+	NoBidInvalidRequest           NoBidReason = 2
+	NoBidKnownWebSpider           NoBidReason = 3
+	NoBidSuspectedNonHumanTraffic NoBidReason = 4
+	NoBidProxyIP                  NoBidReason = 5
+	NoBidUnsupportedDevice        NoBidReason = 6
+	NoBidBlockedPublisher         NoBidReason = 7
+	NoBidUnmatchedUser            NoBidReason = 8
+)
+
+var noBidReasonNames = map[NoBidReason]string{
+	NoBidUnknownError:             "Unknown Error",
+	NoBidTechnicalError:           "Technical Error",
+	NoBidInvalidRequest:           "Invalid Request",
+	NoBidKnownWebSpider:           "Known Web Spider",
+	NoBidSuspectedNonHumanTraffic: "Suspected Non-Human Traffic",
+	NoBidProxyIP:                  "Cloud, Data center, or Proxy IP",
+	NoBidUnsupportedDevice:        "Unsupported Device",
+	NoBidBlockedPublisher:         "Blocked Publisher or Site",
+	NoBidUnmatchedUser:            "Unmatched User",
+}
+
+func (n NoBidReason) String() string {
+	if s, ok := noBidReasonNames[n]; ok {
+		return s
+	}
+	return "NoBidReason(" + strconv.Itoa(int(n)) + ")"
+}
+
+// IsValid reports if n is one of the values defined by list 5.24
+func (n NoBidReason) IsValid() bool {
+	_, ok := noBidReasonNames[n]
+	return ok
+}
+
+// LossReason is the reason a bid lost the auction or was filtered by the exchange, refer to list 5.25
+type LossReason int
+
+// 5.25 Loss Reason Codes
+const (
+	LossBidWon                         LossReason = 0
+	LossInternalError                  LossReason = 1
+	LossImpressionOpportunityExpired   LossReason = 2
+	LossInvalidBidResponse             LossReason = 3
+	LossInvalidDealID                  LossReason = 4
+	LossInvalidAuctionID               LossReason = 5
+	LossInvalidAdvertiserDomain        LossReason = 6
+	LossMissingMarkup                  LossReason = 7
+	LossMissingCreativeID              LossReason = 8
+	LossMissingBidPrice                LossReason = 9
+	LossMissingMinimumCreativeApproval LossReason = 10
+	LossBelowAuctionFloor              LossReason = 100
+	LossBelowDealFloor                 LossReason = 101
+	LossLostToHigherBid                LossReason = 102
+	LossLostToPMPDeal                  LossReason = 103
+	LossBuyerSeatBlocked               LossReason = 104
+	LossCreativeFiltered               LossReason = 200
+	LossCreativePendingProcessing      LossReason = 201
+	LossCreativeDisapproved            LossReason = 202
+	LossCreativeSizeNotAllowed         LossReason = 203
+	LossCreativeIncorrectFormat        LossReason = 204
+	LossAdvertiserExclusions           LossReason = 205
+	LossAppBundleExclusions            LossReason = 206
+	LossCreativeNotSecure              LossReason = 207
+	LossLanguageExclusions             LossReason = 208
+	LossCategoryExclusions             LossReason = 209
+	LossCreativeAttributeExclusions    LossReason = 210
+	LossAdTypeExclusions               LossReason = 211
+	LossAnimationTooLong               LossReason = 212
+	LossCreativeNotAllowedInPMPDeal    LossReason = 213
+	LossExchangeSpecific               LossReason = 1000 // 1000+ are exchange specific
+)
+
+var lossReasonNames = map[LossReason]string{
+	LossBidWon:                         "Bid Won",
+	LossInternalError:                  "Internal Error",
+	LossImpressionOpportunityExpired:   "Impression Opportunity Expired",
+	LossInvalidBidResponse:             "Invalid Bid Response",
+	LossInvalidDealID:                  "Invalid Deal ID",
+	LossInvalidAuctionID:               "Invalid Auction ID",
+	LossInvalidAdvertiserDomain:        "Invalid Advertiser Domain",
+	LossMissingMarkup:                  "Missing Markup",
+	LossMissingCreativeID:              "Missing Creative ID",
+	LossMissingBidPrice:                "Missing Bid Price",
+	LossMissingMinimumCreativeApproval: "Missing Minimum Creative Approval Data",
+	LossBelowAuctionFloor:              "Bid was Below Auction Floor",
+	LossBelowDealFloor:                 "Bid was Below Deal Floor",
+	LossLostToHigherBid:                "Lost to Higher Bid",
+	LossLostToPMPDeal:                  "Lost to a Bid for a PMP Deal",
+	LossBuyerSeatBlocked:               "Buyer Seat Blocked",
+	LossCreativeFiltered:               "Creative Filtered - General",
+	LossCreativePendingProcessing:      "Creative Filtered - Pending Processing by Exchange",
+	LossCreativeDisapproved:            "Creative Filtered - Disapproved by Exchange",
+	LossCreativeSizeNotAllowed:         "Creative Filtered - Size Not Allowed",
+	LossCreativeIncorrectFormat:        "Creative Filtered - Incorrect Creative Format",
+	LossAdvertiserExclusions:           "Creative Filtered - Advertiser Exclusions",
+	LossAppBundleExclusions:            "Creative Filtered - App Bundle Exclusions",
+	LossCreativeNotSecure:              "Creative Filtered - Not Secure",
+	LossLanguageExclusions:             "Creative Filtered - Language Exclusions",
+	LossCategoryExclusions:             "Creative Filtered - Category Exclusions",
+	LossCreativeAttributeExclusions:    "Creative Filtered - Creative Attribute Exclusions",
+	LossAdTypeExclusions:               "Creative Filtered - Ad Type Exclusions",
+	LossAnimationTooLong:               "Creative Filtered - Animation Too Long",
+	LossCreativeNotAllowedInPMPDeal:    "Creative Filtered - Not Allowed in PMP Deal",
+}
+
+func (l LossReason) String() string {
+	if s, ok := lossReasonNames[l]; ok {
+		return s
+	}
+	if l >= LossExchangeSpecific {
+		return "Exchange Specific " + strconv.Itoa(int(l))
+	}
+	return "LossReason(" + strconv.Itoa(int(l)) + ")"
+}
+
+// IsValid reports if l is one of the values defined by list 5.25, exchange specific codes are always valid
+func (l LossReason) IsValid() bool {
+	_, ok := lossReasonNames[l]
+	return ok || l >= LossExchangeSpecific
+}
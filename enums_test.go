@@ -0,0 +1,62 @@
+package twofive
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnums(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface {
+			String() string
+			IsValid() bool
+		}
+		want  string
+		valid bool
+	}{
+		{name: "Protocol", value: ProtocolVAST3Wrapper, want: "VAST 3.0 Wrapper", valid: true},
+		{name: "Unknown Protocol", value: Protocol(11), want: "Protocol(11)"},
+		{name: "Position", value: PositionFullScreen, want: "Full Screen", valid: true},
+		{name: "Mid-Roll", value: StartDelay(15), want: "Mid-Roll 15s", valid: true},
+		{name: "Unknown Start Delay", value: StartDelay(-3), want: "StartDelay(-3)"},
+		{name: "Connection", value: ConnectionCellular4G, want: "Cellular Network - 4G", valid: true},
+		{name: "No Bid", value: NoBidUnmatchedUser, want: "Unmatched User", valid: true},
+		{name: "Loss", value: LossBelowDealFloor, want: "Bid was Below Deal Floor", valid: true},
+		{name: "Exchange Specific Loss", value: LossReason(1001), want: "Exchange Specific 1001", valid: true},
+		{name: "Unknown Loss", value: LossReason(300), want: "LossReason(300)"},
+		{name: "Native Sub Context", value: NativeSubContextProductReview, want: "Product reviews site primarily", valid: true},
+		{name: "Data Asset", value: DataCTAText, want: "ctatext", valid: true},
+		{name: "Exchange Specific Data Asset", value: DataAssetType(501), want: "Exchange Specific 501", valid: true},
+		{name: "Unknown Event Tracking Method", value: EventTrackingMethod(3), want: "EventTrackingMethod(3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if got := tt.value.IsValid(); got != tt.valid {
+				t.Errorf("IsValid() = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestEnumsJSON(t *testing.T) {
+	var v Video
+	if err := json.Unmarshal([]byte(`{"protocols":[2,6],"placement":1,"startdelay":-1}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Protocols) != 2 || v.Protocols[1] != ProtocolVAST3Wrapper || v.Placement != PlacementInStream || v.StartDelay != StartDelayGenericMidRoll {
+		t.Errorf("unexpected video %+v", v)
+	}
+
+	b, err := json.Marshal(Geo{Type: LocationGPS, IPService: IPServiceMaxMind})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"type":1,"ipservice":3}` {
+		t.Errorf("Marshal() = %s, want integers", b)
+	}
+}
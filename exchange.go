@@ -0,0 +1,163 @@
+package twofive
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultNetworkBuffer is the part of tmax an Exchange keeps for itself when it doesn't set its own
+const DefaultNetworkBuffer = 20 * time.Millisecond
+
+// Adapter shapes the request sent to a single demand partner, it's given a copy it may modify freely
+type Adapter interface {
+	Adapt(req *Request) error
+}
+
+// AdapterFunc adapts an ordinary function to an Adapter
+type AdapterFunc func(req *Request) error
+
+// Adapt calls f(req)
+func (f AdapterFunc) Adapt(req *Request) error { return f(req) }
+
+// RewriteTagID sets the tagid of every impression to the one returned for it, e.g. the partner's placement id
+func RewriteTagID(tagID func(imp *Imp) string) Adapter {
+	return AdapterFunc(func(req *Request) error {
+		for i := range req.Imp {
+			req.Imp[i].TagID = tagID(&req.Imp[i])
+		}
+		return nil
+	})
+}
+
+// MapBidFloor sets the bid floor of every impression to the one returned for it, e.g. to add a margin
+func MapBidFloor(floor func(imp *Imp) float64) Adapter {
+	return AdapterFunc(func(req *Request) error {
+		for i := range req.Imp {
+			req.Imp[i].BidFloor = floor(&req.Imp[i])
+		}
+		return nil
+	})
+}
+
+// InjectExt sets the key of the request ext to v
+func InjectExt(key string, v interface{}) Adapter {
+	return AdapterFunc(func(req *Request) error {
+		return req.Ext.SetExt(key, v)
+	})
+}
+
+// Partner is a demand partner of an Exchange, Name identifies it in the result and is the seat of any seat
+// bid it leaves unnamed
+type Partner struct {
+	Name     string
+	Bidder   Bidder
+	Adapters []Adapter
+}
+
+// PartnerStats is how a partner fared in a single call of an Exchange
+type PartnerStats struct {
+	Name     string
+	Latency  time.Duration
+	Response *BidResponse // nil on error
+	Bids     int
+	Err      error // of adapting the request or of the bidder
+}
+
+// ExchangeResult is the outcome of a call of an Exchange, Stats are in the order of the partners
+type ExchangeResult struct {
+	*AuctionResult
+	Stats []PartnerStats
+}
+
+// Exchange sends a request to every partner concurrently, each with its own copy shaped by its adapters,
+// and auctions the bids they return. Partners have until tmax less the NetworkBuffer to answer, and are sent
+// that as their tmax
+type Exchange struct {
+	Partners      []Partner
+	Auctioneer    Auctioneer    // an Auction with its defaults if nil
+	NetworkBuffer time.Duration // DefaultNetworkBuffer if zero
+}
+
+// Run sends the request to the partners and auctions their responses. An error is only returned when the
+// request can't be auctioned, the errors of partners are in the stats of the result
+func (e *Exchange) Run(ctx context.Context, req *Request) (*ExchangeResult, error) {
+	reqs := make([]*Request, len(e.Partners))
+	for i := range e.Partners {
+		reqs[i] = req.Clone()
+	}
+
+	if req.Tmax > 0 {
+		buffer := e.NetworkBuffer
+		if buffer <= 0 {
+			buffer = DefaultNetworkBuffer
+		}
+		budget := time.Duration(req.Tmax)*time.Millisecond - buffer
+		if budget <= 0 {
+			budget = time.Millisecond
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, budget)
+		defer cancel()
+		for _, r := range reqs {
+			r.Tmax = int(budget / time.Millisecond)
+		}
+	}
+
+	res := &ExchangeResult{Stats: make([]PartnerStats, len(e.Partners))}
+	var wg sync.WaitGroup
+	for i := range e.Partners {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res.Stats[i] = e.Partners[i].bid(ctx, reqs[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var responses []*BidResponse
+	for _, s := range res.Stats {
+		if s.Response != nil && s.Bids > 0 {
+			responses = append(responses, s.Response)
+		}
+	}
+
+	auctioneer := e.Auctioneer
+	if auctioneer == nil {
+		auctioneer = Auction{}
+	}
+	auction, err := auctioneer.Run(req, responses...)
+	if err != nil {
+		return nil, err
+	}
+	res.AuctionResult = auction
+	return res, nil
+}
+
+// bid adapts the request and sends it to the partner
+func (p Partner) bid(ctx context.Context, req *Request) PartnerStats {
+	stats := PartnerStats{Name: p.Name}
+	for _, a := range p.Adapters {
+		if stats.Err = a.Adapt(req); stats.Err != nil {
+			return stats
+		}
+	}
+
+	start := time.Now()
+	resp, err := p.Bidder.Bid(ctx, req)
+	stats.Latency = time.Since(start)
+	if err != nil || resp == nil {
+		stats.Err = err
+		return stats
+	}
+
+	for i := range resp.SeatBid {
+		if resp.SeatBid[i].Seat == "" {
+			resp.SeatBid[i].Seat = p.Name
+		}
+		stats.Bids += len(resp.SeatBid[i].Bid)
+	}
+	stats.Response = resp
+	return stats
+}
@@ -0,0 +1,81 @@
+package twofive
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestExchange(t *testing.T) {
+	var mu sync.Mutex
+	received := make(map[string]*Request)
+	partner := func(name string, price float64, adapters ...Adapter) Partner {
+		return Partner{
+			Name:     name,
+			Adapters: adapters,
+			Bidder: BidderFunc(func(ctx context.Context, req *Request) (*BidResponse, error) {
+				mu.Lock()
+				received[name] = req
+				mu.Unlock()
+				if price == 0 {
+					<-ctx.Done()
+					return nil, ctx.Err()
+				}
+				return &BidResponse{ID: req.ID, SeatBid: []Seatbid{{Bid: []Bid{{ID: name, ImpID: req.Imp[0].ID, Price: price}}}}}, nil
+			}),
+		}
+	}
+
+	e := &Exchange{Partners: []Partner{
+		partner("a", 3,
+			RewriteTagID(func(imp *Imp) string { return "a-" + imp.ID[:8] }),
+			MapBidFloor(func(imp *Imp) float64 { return imp.BidFloor * 1.2 }),
+			InjectExt("partner", "a"),
+			AdapterFunc(func(req *Request) error { req.User = User{}; return nil }),
+		),
+		partner("b", 2.5),
+		partner("slow", 0),
+		partner("broken", 4, AdapterFunc(func(*Request) error { return errors.New("no placement") })),
+	}}
+
+	req := loadRequest(t, "./test_data/static_bid_request.json")
+	req.Tmax = 100
+	res, err := e.Run(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if w, ok := res.Winner(staticImpID); !ok || w.Bid.ID != "a" || w.Seat != "a" {
+		t.Errorf("Winner() = %+v, want the bid of a", w)
+	}
+
+	a := received["a"]
+	if a.Imp[0].TagID != "a-73e64f05" || a.Imp[0].BidFloor != 2.4 || a.User.Gender != "" || a.Tmax != 80 {
+		t.Errorf("a received %+v", a)
+	}
+	var ext string
+	if err := a.Ext.GetExt("partner", &ext); err != nil || ext != "a" {
+		t.Errorf("a received ext partner %q, %v", ext, err)
+	}
+	if b := received["b"]; b.Imp[0].TagID != "" || b.Imp[0].BidFloor != 2 || b.User.Gender != "M" {
+		t.Errorf("b received %+v", b)
+	}
+	if req.Imp[0].TagID != "" || req.User.Gender != "M" || req.Tmax != 100 {
+		t.Errorf("the request was modified %+v", req)
+	}
+
+	want := []struct {
+		name string
+		bids int
+		err  bool
+	}{{"a", 1, false}, {"b", 1, false}, {"slow", 0, true}, {"broken", 0, true}}
+	for i, s := range res.Stats {
+		if s.Name != want[i].name || s.Bids != want[i].bids || (s.Err != nil) != want[i].err {
+			t.Errorf("stats[%d] = %+v, want %+v", i, s, want[i])
+		}
+	}
+	if !errors.Is(res.Stats[2].Err, context.DeadlineExceeded) {
+		t.Errorf("slow err = %v, want the deadline exceeded", res.Stats[2].Err)
+	}
+}
@@ -0,0 +1,295 @@
+package twofive
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrExtNotFound is returned by GetExt when the ext doesn't contain the key
+var ErrExtNotFound = errors.New("twofive: ext key not found")
+
+// Extensions holds the keys of an ext object that aren't modelled as fields of the ext type, so that ext data
+// partners send survives an unmarshal and marshal round trip untouched. It's embedded in every *Ext type, the
+// keys that are modelled as fields are read and written through the fields instead. Keys with a type
+// registered through RegisterExt are decoded into that type on unmarshal, the rest are kept as raw JSON
+type Extensions struct {
+	raw   map[string]json.RawMessage
+	typed map[string]interface{}
+	order []string // the keys in the order they were decoded or set in
+}
+
+// GetExt decodes the value of key into v, ErrExtNotFound is returned if the key isn't present
+func (e Extensions) GetExt(key string, v interface{}) error {
+	if t, ok := e.typed[key]; ok {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return &json.InvalidUnmarshalError{Type: reflect.TypeOf(v)}
+		}
+		// registered values are held as pointers, copy directly when v is the same type, deeply so that it
+		// doesn't share the slices and maps of the ext
+		if rv.Type() == reflect.TypeOf(t) {
+			rv.Elem().Set(reflect.ValueOf(cloneTyped(t)).Elem())
+			return nil
+		}
+		raw, err := json.Marshal(t)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(raw, v)
+	}
+
+	raw, ok := e.raw[key]
+	if !ok {
+		return ErrExtNotFound
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// TypedExt returns the value decoded into the type registered for key, as a pointer to that type
+func (e Extensions) TypedExt(key string) (interface{}, bool) {
+	t, ok := e.typed[key]
+	return t, ok
+}
+
+// SetExt encodes v as the raw value of key, a nil v removes the key. A registered value set this way is only
+// available through TypedExt after the next unmarshal
+func (e *Extensions) SetExt(key string, v interface{}) error {
+	if v == nil {
+		e.remove(key)
+		return nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	e.putRaw(key, raw)
+	return nil
+}
+
+// RawExt returns the undecoded value of key, registered values are encoded again
+func (e Extensions) RawExt(key string) (json.RawMessage, bool) {
+	if t, ok := e.typed[key]; ok {
+		raw, err := json.Marshal(t)
+		return raw, err == nil
+	}
+	raw, ok := e.raw[key]
+	return raw, ok
+}
+
+// ExtKeys returns the sorted keys held
+func (e Extensions) ExtKeys() []string {
+	keys := make([]string, 0, len(e.raw)+len(e.typed))
+	for k := range e.raw {
+		keys = append(keys, k)
+	}
+	for k := range e.typed {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ExtOwner is every object with an ext, typed extensions are registered against one of them
+type ExtOwner interface {
+	*Request | *Source | *Regs | *Imp | *Metric | *Banner | *Video | *Audio | *Native | *Format | *App | *Site |
+		*Publisher | *Content | *Producer | *Device | *Geo | *User | *Data | *Segment | *PMP | *Deal |
+		*BidResponse | *Seatbid | *Bid |
+		*NativeRequest | *NativeAsset | *NativeTitle | *NativeImage | *NativeVideo | *NativeData | *NativeEventTracker |
+		*NativeResponse | *NativeLink | *NativeResponseAsset | *NativeTitleResponse | *NativeImageResponse |
+		*NativeDataResponse | *NativeEventTrackerResponse
+}
+
+var registry = struct {
+	sync.RWMutex
+	types map[reflect.Type]map[string]reflect.Type // ext type -> key -> registered type
+}{types: make(map[reflect.Type]map[string]reflect.Type)}
+
+// RegisterExt registers the type of proto for key within the ext of T, so that unmarshalling decodes the key
+// into a new value of that type, available from TypedExt, rather than keeping it as raw JSON. For example
+//
+//	twofive.RegisterExt[*twofive.Imp]("prebid", PrebidImpExt{})
+//
+// makes imp.ext.prebid available as a *PrebidImpExt. Integration specific ext fields are better registered
+// than added to the shared ext types. Registering the same key again replaces the type, a nil proto removes it
+func RegisterExt[T ExtOwner](key string, proto interface{}) {
+	f, _ := reflect.TypeOf((T)(nil)).Elem().FieldByName("Ext")
+	ext := f.Type
+	if ext.Kind() == reflect.Ptr {
+		ext = ext.Elem()
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	// the types of an ext are copied on write, as decoders read them without the lock once registered returns
+	types := make(map[string]reflect.Type, len(registry.types[ext])+1)
+	for k, t := range registry.types[ext] {
+		types[k] = t
+	}
+	if proto == nil {
+		delete(types, key)
+	} else {
+		t := reflect.TypeOf(proto)
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		types[key] = t
+	}
+	registry.types[ext] = types
+}
+
+// registered returns the types registered for the ext type, which must not be modified. RegisterExt replaces
+// the map rather than modifying it, so it's safe to read after the lock is released
+func registered(ext reflect.Type) map[string]reflect.Type {
+	registry.RLock()
+	defer registry.RUnlock()
+	return registry.types[ext]
+}
+
+// The ext types are encoded and decoded by the methods generated into json_gen.go. Their modelled fields are
+// encoded first, followed by the keys of their Extensions in the order they were decoded, then set in, raw
+// values written back byte for byte as they were read, modelled fields taking precedence over a key of the
+// same name. Keys matching a modelled field case insensitively, as encoding/json matches
+// them, decode into the field, those registered for the type into a new value of the registered type, and
+// the rest are kept as raw JSON
+
+// extension decodes the value of key, which isn't a modelled field of owner, into e
+func (r *jsonReader) extension(e *Extensions, owner interface{}, key []byte) {
+	raw, ok := r.raw()
+	if !ok {
+		return
+	}
+
+	k := string(key)
+	if t, ok := registered(reflect.TypeOf(owner).Elem())[k]; ok {
+		typed := reflect.New(t)
+		if err := json.Unmarshal(raw, typed.Interface()); err != nil {
+			if !r.lenient {
+				r.fail(err)
+				return
+			}
+			// a lenient reader keeps what doesn't decode into the registered type as raw JSON
+			r.coerce(r.pos-len(raw), "json.RawMessage")
+			e.setRaw(k, raw)
+			return
+		}
+		e.putTyped(k, typed.Interface())
+		return
+	}
+	e.setRaw(k, raw)
+}
+
+func (e *Extensions) setRaw(key string, raw []byte) {
+	e.putRaw(key, append(json.RawMessage(nil), raw...))
+}
+
+func (e *Extensions) putRaw(key string, raw json.RawMessage) {
+	e.add(key)
+	delete(e.typed, key)
+	if e.raw == nil {
+		e.raw = make(map[string]json.RawMessage)
+	}
+	e.raw[key] = raw
+}
+
+func (e *Extensions) putTyped(key string, v interface{}) {
+	e.add(key)
+	delete(e.raw, key)
+	if e.typed == nil {
+		e.typed = make(map[string]interface{})
+	}
+	e.typed[key] = v
+}
+
+// add appends the key to the order of the keys, unless it's held already
+func (e *Extensions) add(key string) {
+	if _, ok := e.raw[key]; ok {
+		return
+	}
+	if _, ok := e.typed[key]; ok {
+		return
+	}
+	e.order = append(e.order, key)
+}
+
+func (e *Extensions) remove(key string) {
+	delete(e.raw, key)
+	delete(e.typed, key)
+	for i, k := range e.order {
+		if k == key {
+			e.order = append(e.order[:i:i], e.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// keys returns the keys held in the order they were decoded or set in. Keys the order misses, which only
+// extensions assigned directly have, follow it sorted
+func (e Extensions) keys() []string {
+	if len(e.order) == len(e.raw)+len(e.typed) {
+		return e.order
+	}
+	in := make(map[string]bool, len(e.order))
+	for _, k := range e.order {
+		in[k] = true
+	}
+	keys := append([]string(nil), e.order...)
+	for _, k := range e.ExtKeys() {
+		if !in[k] {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// extensions starts decoding an ext, which replaces the extensions held unless it's null
+func (r *jsonReader) extensions(e *Extensions) {
+	if c, ok := r.peek(); ok && c == '{' {
+		*e = Extensions{}
+	}
+}
+
+// extensions writes the keys of e that aren't among the modelled fields
+func (w *jsonWriter) extensions(e Extensions, fields []string) {
+	if len(e.raw) == 0 && len(e.typed) == 0 {
+		return
+	}
+
+keys:
+	for _, k := range e.keys() {
+		for _, f := range fields {
+			if strings.EqualFold(f, k) {
+				continue keys
+			}
+		}
+
+		v, ok := e.raw[k]
+		t, isTyped := e.typed[k]
+		if isTyped {
+			var err error
+			if v, err = json.Marshal(t); err != nil {
+				if w.err == nil {
+					w.err = err
+				}
+				continue
+			}
+		} else if !ok {
+			continue
+		}
+		if w.buf[len(w.buf)-1] != '{' {
+			w.buf = append(w.buf, ',')
+		}
+		w.string(k)
+		w.buf = append(w.buf, ':')
+		if isTyped {
+			w.compact(v)
+		} else {
+			// raw values are written back as they were read, byte for byte
+			w.buf = append(w.buf, v...)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package twofive
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestExtRoundTrip(t *testing.T) {
+	in := []byte(`{"id":"1","imp":[{"id":"1","banner":{"w":320,"h":50,"ext":{"viewability":{"score":0.75}}},` +
+		`"instl":0,"bidfloor":0,"secure":0,"ext":{"position":"top","prebid":{"bidder":{"appnexus":{"placement_id":12}}}}}],` +
+		`"device":{"ua":"","dnt":0,"lmt":0,"ip":"","ifa":"","ext":{"atts":3}},"format":{},"user":{"ext":{"consent":"abc","eids":[{"source":"id5-sync.com"}]}},` +
+		`"regs":{"coppa":0,"ext":{"gdpr":1,"us_privacy":"1YNN","dsa":{"required":1}}},"ext":{"api_key":"","session_id":"","prebid":{"debug":true}}}`)
+
+	var r Request
+	if err := json.Unmarshal(in, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	var position string
+	if r.Imp[0].Ext.GetExt(ImpExtPosition, &position); position != "top" || r.Regs.Ext.GDPR != 1 || r.Regs.Ext.USPrivacy != "1YNN" || r.User.Ext.Consent != "abc" {
+		t.Fatalf("modelled ext fields weren't decoded: %+v %+v %+v", r.Imp[0].Ext, r.Regs.Ext, r.User.Ext)
+	}
+	if v, _ := r.Imp[0].Ext.TypedExt(ImpExtPosition); v == nil || *v.(*string) != "top" {
+		t.Errorf("TypedExt(%q) = %v, want the registered *string", ImpExtPosition, v)
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r2 Request
+	if err := json.Unmarshal(out, &r2); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ext  Extensions
+		key  string
+		want string
+	}{
+		{name: "Imp", ext: r2.Imp[0].Ext.Extensions, key: "prebid", want: `{"bidder":{"appnexus":{"placement_id":12}}}`},
+		{name: "Banner", ext: r2.Imp[0].Banner.Ext.Extensions, key: "viewability", want: `{"score":0.75}`},
+		{name: "Device", ext: r2.Device.Ext.Extensions, key: "atts", want: `3`},
+		{name: "User", ext: r2.User.Ext.Extensions, key: "eids", want: `[{"source":"id5-sync.com"}]`},
+		{name: "Regs", ext: r2.Regs.Ext.Extensions, key: "dsa", want: `{"required":1}`},
+		{name: "Request", ext: r2.Ext.Extensions, key: "prebid", want: `{"debug":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, ok := tt.ext.RawExt(tt.key)
+			if !ok {
+				t.Fatalf("%s is missing after the round trip, keys %v", tt.key, tt.ext.ExtKeys())
+			}
+			if string(raw) != tt.want {
+				t.Errorf("RawExt(%q) = %s, want %s", tt.key, raw, tt.want)
+			}
+		})
+	}
+
+	if keys := r2.Regs.Ext.ExtKeys(); len(keys) != 1 {
+		t.Errorf("modelled keys should not be kept raw, got %v", keys)
+	}
+}
+
+func TestExtRawBytes(t *testing.T) {
+	in := `{"zeta": {"b" : [1, 2.50], "a":"<b>"},"alpha":1e2,"mid":"\u00e9"}`
+	var ext DeviceExt
+	if err := ext.UnmarshalJSON([]byte(in)); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ext.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the keys keep their order and values, only the whitespace between the keys goes
+	want := `{"zeta":{"b" : [1, 2.50], "a":"<b>"},"alpha":1e2,"mid":"\u00e9"}`
+	if string(out) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", out, want)
+	}
+
+	// keys set are written after those decoded, removing one keeps the order of the rest
+	ext.SetExt("new", 1)
+	ext.SetExt("zeta", nil)
+	ext.SetExt("alpha", 2)
+	if out, _ := ext.MarshalJSON(); string(out) != `{"alpha":2,"mid":"\u00e9","new":1}` {
+		t.Errorf("MarshalJSON() = %s", out)
+	}
+}
+
+func TestGetSetExt(t *testing.T) {
+	var ext RegsExt
+	if err := json.Unmarshal([]byte(`{"gdpr":1,"dsa":{"required":1}}`), &ext); err != nil {
+		t.Fatal(err)
+	}
+
+	var dsa struct {
+		Required int `json:"required"`
+	}
+	if err := ext.GetExt("dsa", &dsa); err != nil || dsa.Required != 1 {
+		t.Errorf(`GetExt("dsa") = %+v, %v`, dsa, err)
+	}
+	if err := ext.GetExt("gpp", &dsa); err != ErrExtNotFound {
+		t.Errorf(`GetExt("gpp") = %v, want ErrExtNotFound`, err)
+	}
+
+	type gpp struct {
+		String string `json:"string"`
+		SID    []int  `json:"sid"`
+	}
+	if err := ext.SetExt("gpp", gpp{String: "DBABMA", SID: []int{2}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ext.SetExt("dsa", nil); err != nil {
+		t.Fatal(err)
+	}
+	// modelled fields take precedence over a raw key of the same name
+	if err := ext.SetExt("gdpr", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(ext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"gdpr":1,"gpp":{"string":"DBABMA","sid":[2]}}`; string(b) != want {
+		t.Errorf("Marshal() = %s, want %s", b, want)
+	}
+
+	b, err = json.Marshal(BidExt{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{}` {
+		t.Errorf("Marshal() of an empty ext = %s, want {}", b)
+	}
+}
+
+func TestRegisterExt(t *testing.T) {
+	type prebid struct {
+		Bidder map[string]json.RawMessage `json:"bidder"`
+	}
+	RegisterExt[*Imp]("prebid", prebid{})
+	defer RegisterExt[*Imp]("prebid", nil)
+
+	in := []byte(`{"id":"1","imp":[{"id":"1","ext":{"position":"top","prebid":{"bidder":{"appnexus":{"placement_id":12}}},"gpid":"/1/home"}}],` +
+		`"ext":{"prebid":{"debug":true}}}`)
+	var r Request
+	if err := json.Unmarshal(in, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	v, ok := r.Imp[0].Ext.TypedExt("prebid")
+	p, isPrebid := v.(*prebid)
+	if !ok || !isPrebid {
+		t.Fatalf("TypedExt(\"prebid\") = %T, %v, want *prebid", v, ok)
+	}
+	if string(p.Bidder["appnexus"]) != `{"placement_id":12}` {
+		t.Errorf("prebid.bidder = %s", p.Bidder)
+	}
+	var got prebid
+	if err := r.Imp[0].Ext.GetExt("prebid", &got); err != nil || len(got.Bidder) != 1 {
+		t.Errorf("GetExt(\"prebid\") = %+v, %v", got, err)
+	}
+	got.Bidder["ix"] = json.RawMessage(`{"site_id":1}`)
+	if len(p.Bidder) != 1 {
+		t.Errorf("changing the value GetExt returned changed the ext: %s", p.Bidder)
+	}
+	var nilPrebid *prebid
+	for _, v := range []interface{}{nil, got, nilPrebid} {
+		if err := r.Imp[0].Ext.GetExt("prebid", v); err == nil {
+			t.Errorf("GetExt(\"prebid\", %#v) = nil, want an error", v)
+		}
+	}
+
+	// only the owner it was registered for is typed, the rest stays raw
+	if _, ok := r.Imp[0].Ext.TypedExt("gpid"); ok {
+		t.Error("gpid isn't registered but was typed")
+	}
+	if _, ok := r.Ext.TypedExt("prebid"); ok {
+		t.Error("request ext prebid isn't registered but was typed")
+	}
+	if raw, _ := r.Ext.RawExt("prebid"); string(raw) != `{"debug":true}` {
+		t.Errorf("RawExt(\"prebid\") = %s", raw)
+	}
+
+	p.Bidder["rubicon"] = json.RawMessage(`{"zone_id":3}`)
+	b, err := json.Marshal(r.Imp[0].Ext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"position":"top","prebid":{"bidder":{"appnexus":{"placement_id":12},"rubicon":{"zone_id":3}}},"gpid":"/1/home"}`
+	if string(b) != want {
+		t.Errorf("Marshal() = %s, want %s", b, want)
+	}
+}
+
+// TestRegisterExtConcurrent registers ext types while requests are decoded, for the race detector to catch the
+// registry being read and written at once
+func TestRegisterExtConcurrent(t *testing.T) {
+	type typed struct {
+		N int `json:"n"`
+	}
+	in := []byte(`{"id":"1","imp":[{"id":"1","ext":{"k0":{"n":1},"k1":{"n":2}}}]}`)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			key := "k" + strconv.Itoa(i%2)
+			RegisterExt[*Imp](key, typed{})
+			RegisterExt[*Imp](key, nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			var r Request
+			if err := json.Unmarshal(in, &r); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
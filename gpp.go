@@ -0,0 +1,370 @@
+package twofive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidGPPString is wrapped by the errors of ParseGPPString
+var ErrInvalidGPPString = errors.New("twofive: invalid GPP string")
+
+// The IDs of the sections of a GPP string decoded by ParseGPPString
+const (
+	GPPSectionTCFEUv2    = 2
+	GPPSectionUSPrivacy  = 6
+	GPPSectionUSNational = 7
+	GPPSectionUSCA       = 8
+	GPPSectionUSVA       = 9
+	GPPSectionUSCO       = 10
+)
+
+// USSection is the US national section of a GPP string, or the section of a US state. The signals are Y for
+// notice given, opted out or consent given, N for their absence and - for not applicable: Y is a notice given
+// for the notices, an opt out for the opt outs, and a consent given for the consents, that is
+// KnownChildSensitiveDataConsents, PersonalDataConsents and, in the sections of Virginia and Colorado,
+// SensitiveDataProcessing, which is an opt out in the others. The fields a section doesn't have are left zero
+type USSection struct {
+	SectionID                           int
+	Version                             int
+	SharingNotice                       PrivacySignal
+	SaleOptOutNotice                    PrivacySignal
+	SharingOptOutNotice                 PrivacySignal
+	TargetedAdvertisingOptOutNotice     PrivacySignal
+	SensitiveDataProcessingOptOutNotice PrivacySignal
+	SensitiveDataLimitUseNotice         PrivacySignal
+	SaleOptOut                          PrivacySignal
+	SharingOptOut                       PrivacySignal
+	TargetedAdvertisingOptOut           PrivacySignal
+	SensitiveDataProcessing             []PrivacySignal
+	KnownChildSensitiveDataConsents     []PrivacySignal
+	PersonalDataConsents                PrivacySignal
+	MSPACoveredTransaction              PrivacySignal
+	MSPAOptOutOptionMode                PrivacySignal
+	MSPAServiceProviderMode             PrivacySignal
+	GPC                                 bool
+}
+
+// GPPString is an IAB Global Privacy Platform string, as passed in Regs.GPP. SectionIDs are the sections it
+// encodes in order, Sections their encodings by ID, and the sections ParseGPPString decodes are set when present
+type GPPString struct {
+	Version    int
+	SectionIDs []int
+	Sections   map[int]string
+
+	TCFEU      *TCString
+	USPrivacy  *USPrivacy
+	USNational *USSection
+	USStates   map[int]*USSection // keyed by section ID, e.g. GPPSectionUSCA
+}
+
+// ParseGPPString decodes the header of a GPP string and the sections of the TCF EU v2, the US Privacy string,
+// the US national section and the sections of California, Virginia and Colorado. Other sections are only kept
+// encoded in Sections. The error wraps ErrInvalidGPPString
+func ParseGPPString(s string) (*GPPString, error) {
+	parts := strings.Split(s, "~")
+	r, err := newBitReader(parts[0], ErrInvalidGPPString)
+	if err != nil {
+		return nil, err
+	}
+	typ := r.int(6)
+	g := &GPPString{Version: r.int(6), SectionIDs: r.fibonacciRanges()}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if typ != 3 || g.Version != 1 {
+		return nil, fmt.Errorf("%w: header type %d version %d", ErrInvalidGPPString, typ, g.Version)
+	}
+	if len(parts)-1 != len(g.SectionIDs) {
+		return nil, fmt.Errorf("%w: %d sections for %d ids", ErrInvalidGPPString, len(parts)-1, len(g.SectionIDs))
+	}
+
+	g.Sections = make(map[int]string, len(g.SectionIDs))
+	for i, id := range g.SectionIDs {
+		section := parts[i+1]
+		g.Sections[id] = section
+		switch id {
+		case GPPSectionTCFEUv2:
+			if g.TCFEU, err = ParseTCString(section); err != nil {
+				return nil, fmt.Errorf("%w: section %d: %v", ErrInvalidGPPString, id, err)
+			}
+		case GPPSectionUSPrivacy:
+			p, err := ParseUSPrivacy(section)
+			if err != nil {
+				return nil, fmt.Errorf("%w: section %d: %v", ErrInvalidGPPString, id, err)
+			}
+			g.USPrivacy = &p
+		case GPPSectionUSNational:
+			if g.USNational, err = parseUSSection(id, section); err != nil {
+				return nil, err
+			}
+		case GPPSectionUSCA, GPPSectionUSVA, GPPSectionUSCO:
+			u, err := parseUSSection(id, section)
+			if err != nil {
+				return nil, err
+			}
+			if g.USStates == nil {
+				g.USStates = make(map[int]*USSection)
+			}
+			g.USStates[id] = u
+		}
+	}
+	return g, nil
+}
+
+// usSections returns the US national and state sections of the GPP string
+func (g *GPPString) usSections() []*USSection {
+	var sections []*USSection
+	if g.USNational != nil {
+		sections = append(sections, g.USNational)
+	}
+	for _, id := range g.SectionIDs {
+		if u, ok := g.USStates[id]; ok {
+			sections = append(sections, u)
+		}
+	}
+	return sections
+}
+
+// parseUSSection decodes the core segment of a US section and its optional GPC segment
+func parseUSSection(id int, s string) (*USSection, error) {
+	segments := strings.Split(s, ".")
+	r, err := newBitReader(segments[0], ErrInvalidGPPString)
+	if err != nil {
+		return nil, err
+	}
+	u := &USSection{SectionID: id, Version: r.int(6)}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if u.Version != 1 && (id != GPPSectionUSNational || u.Version != 2) {
+		return nil, fmt.Errorf("%w: section %d version %d", ErrInvalidGPPString, id, u.Version)
+	}
+
+	var sensitive, children int
+	gpc, sensitiveConsents := true, false
+	switch id {
+	case GPPSectionUSNational:
+		r.signals(&u.SharingNotice, &u.SaleOptOutNotice, &u.SharingOptOutNotice, &u.TargetedAdvertisingOptOutNotice,
+			&u.SensitiveDataProcessingOptOutNotice, &u.SensitiveDataLimitUseNotice, &u.SaleOptOut, &u.SharingOptOut,
+			&u.TargetedAdvertisingOptOut)
+		sensitive, children = 12, 2
+		if u.Version == 2 {
+			sensitive, children = 16, 3
+		}
+	case GPPSectionUSCA:
+		r.signals(&u.SaleOptOutNotice, &u.SharingOptOutNotice, &u.SensitiveDataLimitUseNotice, &u.SaleOptOut,
+			&u.SharingOptOut)
+		sensitive, children = 9, 2
+	case GPPSectionUSVA:
+		r.signals(&u.SharingNotice, &u.SaleOptOutNotice, &u.TargetedAdvertisingOptOutNotice, &u.SaleOptOut,
+			&u.TargetedAdvertisingOptOut)
+		sensitive, children, gpc, sensitiveConsents = 8, 1, false, true
+	case GPPSectionUSCO:
+		r.signals(&u.SharingNotice, &u.SaleOptOutNotice, &u.TargetedAdvertisingOptOutNotice, &u.SaleOptOut,
+			&u.TargetedAdvertisingOptOut)
+		sensitive, children, sensitiveConsents = 7, 1, true
+	}
+	if sensitiveConsents {
+		u.SensitiveDataProcessing = r.signalList(gppConsents, sensitive)
+	} else {
+		u.SensitiveDataProcessing = r.signalList(gppSignals, sensitive)
+	}
+	u.KnownChildSensitiveDataConsents = r.signalList(gppConsents, children)
+	if id == GPPSectionUSNational || id == GPPSectionUSCA {
+		r.consents(&u.PersonalDataConsents)
+	}
+	r.signals(&u.MSPACoveredTransaction, &u.MSPAOptOutOptionMode, &u.MSPAServiceProviderMode)
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if gpc && len(segments) > 1 {
+		r, err := newBitReader(segments[1], ErrInvalidGPPString)
+		if err != nil {
+			return nil, err
+		}
+		if r.int(2) == 1 {
+			u.GPC = r.bool()
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	return u, nil
+}
+
+// gppSignals are the signals of the two bit fields of the US sections, 1 for a notice given or an opt out, 3
+// isn't one
+var gppSignals = [...]PrivacySignal{SignalNotApplicable, SignalYes, SignalNo}
+
+// gppConsents are the signals of the two bit consent fields of the US sections, the other way round, 1 for no
+// consent and 2 for a consent given
+var gppConsents = [...]PrivacySignal{SignalNotApplicable, SignalNo, SignalYes}
+
+// signals reads two bit fields into the signals
+func (r *bitReader) signals(signals ...*PrivacySignal) {
+	r.readSignals(gppSignals, signals)
+}
+
+// consents reads two bit consent fields into the signals
+func (r *bitReader) consents(signals ...*PrivacySignal) {
+	r.readSignals(gppConsents, signals)
+}
+
+func (r *bitReader) readSignals(table [3]PrivacySignal, signals []*PrivacySignal) {
+	for _, s := range signals {
+		v := r.int(2)
+		if v >= len(table) && r.err == nil {
+			r.err = fmt.Errorf("%w: signal %d", r.invalid, v)
+		}
+		if r.err != nil {
+			return
+		}
+		*s = table[v]
+	}
+}
+
+// signalList reads n two bit fields by the table, gppSignals or gppConsents
+func (r *bitReader) signalList(table [3]PrivacySignal, n int) []PrivacySignal {
+	list := make([]PrivacySignal, n)
+	for i := range list {
+		r.readSignals(table, []*PrivacySignal{&list[i]})
+	}
+	return list
+}
+
+// fibonacci reads a Fibonacci coded integer, the bits of which are the Fibonacci numbers from 1 up, ended by two
+// bits set
+func (r *bitReader) fibonacci() int {
+	v, last := 0, false
+	for a, b := 1, 2; r.err == nil; a, b = b, a+b {
+		if a > 1<<16 {
+			r.err = fmt.Errorf("%w: Fibonacci integer too large", r.invalid)
+			break
+		}
+		bit := r.bool()
+		if bit && last {
+			return v
+		}
+		if bit {
+			v += a
+		}
+		last = bit
+	}
+	return 0
+}
+
+// fibonacciRanges reads entries of single ids and ranges of them, each id coded as its offset from the one before
+func (r *bitReader) fibonacciRanges() []int {
+	var ids []int
+	last := 0
+	for n := r.int(12); n > 0 && r.err == nil; n-- {
+		isRange := r.bool()
+		start := last + r.fibonacci()
+		end := start
+		if isRange {
+			end = start + r.fibonacci()
+		}
+		if r.err == nil && end > 1<<16 {
+			r.err = fmt.Errorf("%w: section id %d", r.invalid, end)
+		}
+		if r.err != nil {
+			break
+		}
+		for id := start; id <= end; id++ {
+			ids = append(ids, id)
+		}
+		last = end
+	}
+	return ids
+}
+
+// PrivacySignals is a view of the privacy signals of a request, unified from the GPP string, regs.ext.gdpr,
+// user.ext.consent, regs.ext.us_privacy and regs.coppa
+type PrivacySignals struct {
+	GDPR                      bool       // the GDPR applies, by regs.ext.gdpr or the applicable TCF EU section
+	TCString                  *TCString  // the consents of the GDPR, of the TCF EU section or of user.ext.consent
+	SaleOptOut                bool       // opted out of the sale of personal data, by the US Privacy string or a US section
+	SharingOptOut             bool       // opted out of the sharing of personal data, by a US section
+	TargetedAdvertisingOptOut bool       // opted out of targeted advertising, by a US section
+	GPC                       bool       // the Global Privacy Control is set in a US section
+	COPPA                     bool       // regs.coppa is 1
+	GPP                       *GPPString // the GPP string of regs.gpp or regs.ext.gpp
+}
+
+// PrivacySignals derives the privacy signals of the request. The GPP string is read from regs.gpp, or from the
+// gpp key of regs.ext as sent by older SDKs, and only its sections listed in gpp_sid apply when that's set. The
+// signals of the GPP string and the other fields add up, a GDPR or opt out signalled by either counts. A GPP or
+// TC string that can't be decoded is left out, the first such error is returned along with the rest of the signals
+func (r *Request) PrivacySignals() (PrivacySignals, error) {
+	s := PrivacySignals{COPPA: r.Regs.Coppa == 1}
+	gpp, sid := r.Regs.GPP, r.Regs.GPPSID
+	var usPrivacy string
+	if ext := r.Regs.Ext; ext != nil {
+		s.GDPR = ext.GDPR == 1
+		usPrivacy = ext.USPrivacy
+		if gpp == "" {
+			ext.GetExt("gpp", &gpp)
+			ext.GetExt("gpp_sid", &sid)
+		}
+	}
+
+	var first error
+	if gpp != "" {
+		g, err := ParseGPPString(gpp)
+		if err != nil {
+			first = err
+		} else {
+			s.GPP = g
+			s.fromGPP(g, sid)
+		}
+	}
+	if s.TCString == nil && r.User.Ext != nil && r.User.Ext.Consent != "" {
+		tc, err := ParseTCString(r.User.Ext.Consent)
+		if err != nil && first == nil {
+			first = err
+		}
+		s.TCString = tc
+	}
+	if usPrivacy != "" {
+		p, err := ParseUSPrivacy(usPrivacy)
+		if err != nil && first == nil {
+			first = err
+		}
+		s.SaleOptOut = s.SaleOptOut || p.SaleOptedOut()
+	}
+	return s, first
+}
+
+// fromGPP adds the signals of the sections of the GPP string that apply, all of them without sid
+func (s *PrivacySignals) fromGPP(g *GPPString, sid []int) {
+	applies := func(id int) bool {
+		if sid == nil {
+			return true
+		}
+		for _, v := range sid {
+			if v == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	if g.TCFEU != nil && applies(GPPSectionTCFEUv2) {
+		s.GDPR = true
+		s.TCString = g.TCFEU
+	}
+	if g.USPrivacy != nil && applies(GPPSectionUSPrivacy) {
+		s.SaleOptOut = s.SaleOptOut || g.USPrivacy.SaleOptedOut()
+	}
+	for _, u := range g.usSections() {
+		if !applies(u.SectionID) {
+			continue
+		}
+		s.SaleOptOut = s.SaleOptOut || u.SaleOptOut == SignalYes
+		s.SharingOptOut = s.SharingOptOut || u.SharingOptOut == SignalYes
+		s.TargetedAdvertisingOptOut = s.TargetedAdvertisingOptOut || u.TargetedAdvertisingOptOut == SignalYes
+		s.GPC = s.GPC || u.GPC
+	}
+}
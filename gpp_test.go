@@ -0,0 +1,290 @@
+package twofive
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fibonacci writes v as a Fibonacci coded integer
+func (w *bitWriter) fibonacci(v int) *bitWriter {
+	fibs := []int{1, 2}
+	for fibs[len(fibs)-1] <= v {
+		fibs = append(fibs, fibs[len(fibs)-1]+fibs[len(fibs)-2])
+	}
+	bits := make([]bool, len(fibs))
+	n := 0
+	for i := len(fibs) - 1; i >= 0; i-- {
+		if fibs[i] <= v {
+			v -= fibs[i]
+			bits[i] = true
+			if n == 0 {
+				n = i + 1
+			}
+		}
+	}
+	for _, b := range bits[:n] {
+		w.bool(b)
+	}
+	return w.bool(true)
+}
+
+// signals writes two bit fields
+func (w *bitWriter) signals(values ...int) *bitWriter {
+	for _, v := range values {
+		w.int(v, 2)
+	}
+	return w
+}
+
+// gppHeader returns the header of a GPP string of the sections, the ids of which follow each other
+func gppHeader(ids ...int) string {
+	w := new(bitWriter).int(3, 6).int(1, 6).int(len(ids), 12)
+	last := 0
+	for _, id := range ids {
+		w.bool(false).fibonacci(id - last)
+		last = id
+	}
+	return w.String()
+}
+
+func TestParseGPPString(t *testing.T) {
+	tcf := coreSegment([]int{1}, 3).int(0, 16).bool(false).int(0, 12).String()
+	ca := new(bitWriter).int(1, 6).signals(1, 1, 0, 1, 2).signals(make([]int, 11)...).signals(0, 1, 2, 1).String() + "." +
+		new(bitWriter).int(1, 2).bool(true).String()
+	va := new(bitWriter).int(1, 6).signals(1, 1, 2, 2, 1).signals(make([]int, 9)...).signals(2, 2, 0).String()
+	co := new(bitWriter).int(1, 6).signals(0, 0, 0, 0, 0).signals(make([]int, 8)...).signals(0, 0, 0).String()
+
+	// 2, 6 and 8 to 10 as a range
+	header := new(bitWriter).int(3, 6).int(1, 6).int(3, 12).bool(false).fibonacci(2).bool(false).fibonacci(4).
+		bool(true).fibonacci(2).fibonacci(2).String()
+	got, err := ParseGPPString(strings.Join([]string{header, tcf, "1YYN", ca, va, co}, "~"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got.SectionIDs, []int{2, 6, 8, 9, 10}) || got.Version != 1 || got.Sections[GPPSectionUSCA] != ca {
+		t.Errorf("header = %d %v %v", got.Version, got.SectionIDs, got.Sections)
+	}
+	if got.TCFEU == nil || !got.TCFEU.HasVendorConsent(3, 1) {
+		t.Errorf("TCFEU = %+v", got.TCFEU)
+	}
+	if got.USPrivacy == nil || !got.USPrivacy.SaleOptedOut() {
+		t.Errorf("USPrivacy = %+v", got.USPrivacy)
+	}
+	if got.USNational != nil || len(got.USStates) != 3 {
+		t.Fatalf("USNational = %+v, USStates = %+v", got.USNational, got.USStates)
+	}
+
+	none := func(n int) []PrivacySignal {
+		s := make([]PrivacySignal, n)
+		for i := range s {
+			s[i] = SignalNotApplicable
+		}
+		return s
+	}
+	wantCA := &USSection{
+		SectionID:                       GPPSectionUSCA,
+		Version:                         1,
+		SaleOptOutNotice:                SignalYes,
+		SharingOptOutNotice:             SignalYes,
+		SensitiveDataLimitUseNotice:     SignalNotApplicable,
+		SaleOptOut:                      SignalYes,
+		SharingOptOut:                   SignalNo,
+		SensitiveDataProcessing:         none(9),
+		KnownChildSensitiveDataConsents: none(2),
+		PersonalDataConsents:            SignalNotApplicable,
+		MSPACoveredTransaction:          SignalYes,
+		MSPAOptOutOptionMode:            SignalNo,
+		MSPAServiceProviderMode:         SignalYes,
+		GPC:                             true,
+	}
+	if !reflect.DeepEqual(got.USStates[GPPSectionUSCA], wantCA) {
+		t.Errorf("USCA = %+v\nwant %+v", got.USStates[GPPSectionUSCA], wantCA)
+	}
+	if va := got.USStates[GPPSectionUSVA]; va.SharingNotice != SignalYes || va.TargetedAdvertisingOptOut != SignalYes ||
+		len(va.SensitiveDataProcessing) != 8 || va.MSPACoveredTransaction != SignalNo || va.GPC {
+		t.Errorf("USVA = %+v", va)
+	}
+	if co := got.USStates[GPPSectionUSCO]; co.SaleOptOut != SignalNotApplicable || len(co.SensitiveDataProcessing) != 7 {
+		t.Errorf("USCO = %+v", co)
+	}
+
+	usnat, err := ParseGPPString("DBABL~BVVqAAEABgA.QA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantNat := &USSection{
+		SectionID:                           GPPSectionUSNational,
+		Version:                             1,
+		SharingNotice:                       SignalYes,
+		SaleOptOutNotice:                    SignalYes,
+		SharingOptOutNotice:                 SignalYes,
+		TargetedAdvertisingOptOutNotice:     SignalYes,
+		SensitiveDataProcessingOptOutNotice: SignalYes,
+		SensitiveDataLimitUseNotice:         SignalYes,
+		SaleOptOut:                          SignalNo,
+		SharingOptOut:                       SignalNo,
+		TargetedAdvertisingOptOut:           SignalNo,
+		SensitiveDataProcessing:             none(12),
+		KnownChildSensitiveDataConsents:     none(2),
+		PersonalDataConsents:                SignalNo, // 1, no consent
+		MSPACoveredTransaction:              SignalNo,
+		MSPAOptOutOptionMode:                SignalNotApplicable,
+		MSPAServiceProviderMode:             SignalNotApplicable,
+	}
+	wantNat.SensitiveDataProcessing[7] = SignalYes
+	if !reflect.DeepEqual(usnat.USNational, wantNat) {
+		t.Errorf("USNational = %+v\nwant %+v", usnat.USNational, wantNat)
+	}
+}
+
+func TestParseGPPStringConsents(t *testing.T) {
+	// Virginia, sensitive data processing refused for the first category and consented to for the second, and
+	// the known child consent refused
+	va := new(bitWriter).int(1, 6).signals(1, 1, 1, 2, 2).signals(1, 2, 0, 0, 0, 0, 0, 0).signals(1).signals(1, 2, 2).String()
+	g, err := ParseGPPString(gppHeader(GPPSectionUSVA) + "~" + va)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := g.USStates[GPPSectionUSVA]
+	if want := []PrivacySignal{SignalNo, SignalYes}; !reflect.DeepEqual(u.SensitiveDataProcessing[:2], want) {
+		t.Errorf("SensitiveDataProcessing = %v, want %v first", u.SensitiveDataProcessing, want)
+	}
+	if want := []PrivacySignal{SignalNo}; !reflect.DeepEqual(u.KnownChildSensitiveDataConsents, want) {
+		t.Errorf("KnownChildSensitiveDataConsents = %v, want %v", u.KnownChildSensitiveDataConsents, want)
+	}
+	// the opt outs keep 1 for an opt out
+	if u.SaleOptOut != SignalNo || u.SharingNotice != SignalYes || u.MSPACoveredTransaction != SignalYes {
+		t.Errorf("USVA = %+v", u)
+	}
+}
+
+func TestParseGPPStringErrors(t *testing.T) {
+	ca := new(bitWriter).int(1, 6).signals(make([]int, 21)...)
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{name: "empty", s: ""},
+		{name: "not base64", s: "DB!"},
+		{name: "not a header", s: new(bitWriter).int(2, 6).int(1, 6).int(0, 12).String()},
+		{name: "truncated header", s: "DBAB"},
+		{name: "missing section", s: gppHeader(2, 6) + "~1YNN"},
+		{name: "extra section", s: gppHeader(6) + "~1YNN~1YNN"},
+		{name: "bad TC string", s: gppHeader(2) + "~BOEFEAyOEFEAyAHABDENAI4AAAB9vABAASA"},
+		{name: "bad US Privacy", s: gppHeader(6) + "~1YN"},
+		{name: "bad signal", s: gppHeader(8) + "~" + new(bitWriter).int(1, 6).signals(3).signals(make([]int, 20)...).String()},
+		{name: "bad version", s: gppHeader(8) + "~" + new(bitWriter).int(2, 6).signals(make([]int, 21)...).String()},
+		{name: "truncated section", s: gppHeader(8) + "~" + new(bitWriter).int(1, 6).signals(1, 1).String()},
+		{name: "truncated GPC", s: gppHeader(8) + "~" + ca.String() + "."},
+		{name: "large id", s: new(bitWriter).int(3, 6).int(1, 6).int(1, 12).bool(false).fibonacci(1 << 17).String()},
+	}
+
+	if _, err := ParseGPPString(gppHeader(8) + "~" + ca.String()); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseGPPString(tt.s); !errors.Is(err, ErrInvalidGPPString) {
+				t.Errorf("ParseGPPString(%q) error = %v, want ErrInvalidGPPString", tt.s, err)
+			}
+		})
+	}
+}
+
+func TestPrivacySignals(t *testing.T) {
+	consent := coreSegment([]int{1}, 3).int(0, 16).bool(false).int(0, 12).String()
+	saleOptOut := new(bitWriter).int(1, 6).signals(1, 1, 1, 1, 1).signals(make([]int, 11)...).signals(0, 1, 1, 1).String()
+	targeted := new(bitWriter).int(1, 6).signals(1, 1, 1, 2, 1).signals(make([]int, 9)...).signals(0, 1, 1).String()
+
+	tests := []struct {
+		name string
+		regs Regs
+		user *UserExt
+		want PrivacySignals
+		err  error
+	}{
+		{name: "none"},
+		{name: "COPPA", regs: Regs{Coppa: 1}, want: PrivacySignals{COPPA: true}},
+		{name: "GDPR", regs: Regs{Ext: &RegsExt{GDPR: 1}}, user: &UserExt{Consent: consent}, want: PrivacySignals{GDPR: true, TCString: &TCString{}}},
+		{name: "US Privacy", regs: Regs{Ext: &RegsExt{USPrivacy: "1YYN"}}, want: PrivacySignals{SaleOptOut: true}},
+		{name: "invalid US Privacy", regs: Regs{Ext: &RegsExt{USPrivacy: "1YY"}}, err: ErrInvalidUSPrivacy},
+		{name: "GPP TCF", regs: Regs{GPP: gppHeader(2) + "~" + consent}, want: PrivacySignals{GDPR: true, TCString: &TCString{}, GPP: &GPPString{}}},
+		{
+			name: "GPP TCF not applicable",
+			regs: Regs{GPP: gppHeader(2) + "~" + consent, GPPSID: []int{6}},
+			user: &UserExt{Consent: consent},
+			want: PrivacySignals{TCString: &TCString{}, GPP: &GPPString{}},
+		},
+		{name: "GPP US Privacy", regs: Regs{GPP: gppHeader(6) + "~1YYN", GPPSID: []int{6}}, want: PrivacySignals{SaleOptOut: true, GPP: &GPPString{}}},
+		{
+			name: "GPP US state",
+			regs: Regs{GPP: gppHeader(8, 9) + "~" + saleOptOut + "~" + targeted},
+			want: PrivacySignals{SaleOptOut: true, SharingOptOut: true, TargetedAdvertisingOptOut: true, GPP: &GPPString{}},
+		},
+		{
+			name: "GPP US state not applicable",
+			regs: Regs{GPP: gppHeader(8, 9) + "~" + saleOptOut + "~" + targeted, GPPSID: []int{9}},
+			want: PrivacySignals{TargetedAdvertisingOptOut: true, GPP: &GPPString{}},
+		},
+		{
+			name: "GPP GPC",
+			regs: Regs{GPP: "DBABL~BVVqAAEABgA.YA"},
+			want: PrivacySignals{GPC: true, GPP: &GPPString{}},
+		},
+		{
+			name: "GPP in ext",
+			regs: Regs{Ext: extRegs(t, `{"gpp":"`+gppHeader(6)+`~1NYN","gpp_sid":[6]}`)},
+			want: PrivacySignals{SaleOptOut: true, GPP: &GPPString{}},
+		},
+		{
+			name: "invalid GPP",
+			regs: Regs{GPP: gppHeader(6) + "~1YYN~1YYN", Ext: &RegsExt{GDPR: 1}},
+			user: &UserExt{Consent: consent},
+			want: PrivacySignals{GDPR: true, TCString: &TCString{}},
+			err:  ErrInvalidGPPString,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &Request{Regs: tt.regs, User: User{Ext: tt.user}}
+			got, err := req.PrivacySignals()
+			if !errors.Is(err, tt.err) {
+				t.Errorf("PrivacySignals() error = %v, want %v", err, tt.err)
+			}
+			// the strings are only checked for being there
+			if (got.TCString == nil) != (tt.want.TCString == nil) || (got.GPP == nil) != (tt.want.GPP == nil) {
+				t.Errorf("PrivacySignals() TCString = %v, GPP = %v", got.TCString, got.GPP)
+			}
+			got.TCString, got.GPP, tt.want.TCString, tt.want.GPP = nil, nil, nil, nil
+			if got != tt.want {
+				t.Errorf("PrivacySignals() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func extRegs(t *testing.T, data string) *RegsExt {
+	var ext RegsExt
+	if err := ext.UnmarshalJSON([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	return &ext
+}
+
+func FuzzParseGPPString(f *testing.F) {
+	f.Add("DBABL~BVVqAAEABgA.QA")
+	f.Add(gppHeader(2, 6, 8) + "~" + coreSegment([]int{1}, 2).int(0, 16).bool(false).int(0, 12).String() + "~1YNN~" +
+		new(bitWriter).int(1, 6).signals(make([]int, 21)...).String())
+	f.Fuzz(func(t *testing.T, s string) {
+		if g, err := ParseGPPString(s); err == nil {
+			(&Request{Regs: Regs{GPP: s}}).PrivacySignals()
+			if len(g.Sections) > len(g.SectionIDs) {
+				t.Fatalf("%d sections for ids %v", len(g.Sections), g.SectionIDs)
+			}
+		}
+	})
+}
@@ -0,0 +1,213 @@
+package twofive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/timehop/ortb-twofive/twosix"
+)
+
+// DefaultMaxBodyBytes is the largest request body a Handler reads when it doesn't set its own limit
+const DefaultMaxBodyBytes = 1 << 20
+
+// Bidder answers bid requests. A nil response, or one with no bids, is a no bid. Client is a Bidder for a
+// remote bidder
+type Bidder interface {
+	Bid(ctx context.Context, req *Request) (*BidResponse, error)
+}
+
+// BidderFunc adapts an ordinary function to a Bidder
+type BidderFunc func(ctx context.Context, req *Request) (*BidResponse, error)
+
+// Bid calls f(ctx, req)
+func (f BidderFunc) Bid(ctx context.Context, req *Request) (*BidResponse, error) { return f(ctx, req) }
+
+var _ Bidder = (*Client)(nil)
+
+// HandlerError is the body of a 400 written by a Handler, Errors holds the rules the request failed
+type HandlerError struct {
+	Message string           `json:"error"`
+	Errors  ValidationErrors `json:"errors,omitempty"`
+}
+
+// Handler is the http.Handler of a bidder endpoint. It reads POSTed bid requests, gzipped or not, rejecting
+// those of another OpenRTB version or that fail validation with a 400 and a HandlerError. Valid requests are
+// passed to the Bidder with a deadline of their tmax, its response is written as JSON, gzipped if the client
+// accepts it, and a no bid as a 204 unless it gives a reason. A request without the version header is
+// assumed to be of this version, one with the 2.6 header is converted by FromV26 and answered with it. As
+// 2.6 has no format, that of a 2.6 request without one in its ext is the size of its first imp
+type Handler struct {
+	Bidder       Bidder
+	Rules        []Rule // validates the requests in place of the DefaultRules if set
+	MaxBodyBytes int64  // DefaultMaxBodyBytes if zero
+
+	// Coerced makes the requests decode leniently, with UnmarshalLenient, if set. It's called with the
+	// coercions made to a request that needed any, before the request is validated
+	Coerced func(req *Request, coercions []Coercion)
+
+	// Converted is called with the losses of converting a 2.6 request that had any, before the request is
+	// validated. 2.6 requests decode strictly whether or not Coerced is set
+	Converted func(req *Request, losses []Loss)
+}
+
+// NewHandler returns a Handler passing valid requests to the bidder
+func NewHandler(b Bidder) *Handler {
+	return &Handler{Bidder: b}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if v := r.Header.Get(Header); v != "" && !isVersion(v, Version) && !isVersion(v, twosix.Version) {
+		writeJSON(w, r, http.StatusBadRequest, HandlerError{Message: "unsupported " + Header + " " + v})
+		return
+	}
+
+	req, err := h.decode(r)
+	if err != nil {
+		writeJSON(w, r, http.StatusBadRequest, HandlerError{Message: err.Error()})
+		return
+	}
+
+	rules := h.Rules
+	if rules == nil {
+		rules = DefaultRules
+	}
+	if err := req.ValidateWith(rules...); err != nil {
+		errs, _ := err.(ValidationErrors)
+		writeJSON(w, r, http.StatusBadRequest, HandlerError{Message: "invalid bid request", Errors: errs})
+		return
+	}
+
+	ctx := r.Context()
+	if req.Tmax > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.Tmax)*time.Millisecond)
+		defer cancel()
+	}
+
+	resp, err := h.Bidder.Bid(ctx, req)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if resp == nil || (resp.IsNoBid() && resp.NBR == NoBidUnknownError) {
+		w.WriteHeader(StatusNoBid)
+		return
+	}
+	if resp.ID == "" {
+		resp.ID = req.ID
+	}
+	writeJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *Handler) decode(r *http.Request) (*Request, error) {
+	limit := h.MaxBodyBytes
+	if limit <= 0 {
+		limit = DefaultMaxBodyBytes
+	}
+
+	var body io.Reader = r.Body
+	if strings.EqualFold(r.Header.Get(EncodingHeader), EncodingValue) {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		body = zr
+	}
+
+	// limit the decompressed body, so a small gzipped body can't expand without bound
+	body = io.LimitReader(body, limit)
+	if isVersion(r.Header.Get(twosix.Header), twosix.Version) {
+		var v twosix.BidRequest
+		if err := json.NewDecoder(body).Decode(&v); err != nil {
+			return nil, err
+		}
+		req, losses := FromV26(&v)
+		if len(losses) > 0 && h.Converted != nil {
+			h.Converted(req, losses)
+		}
+		if isZero(req.Format) {
+			req.Format.W, req.Format.H = impSize(req.Imp)
+		}
+		return req, nil
+	}
+
+	var req Request
+	if h.Coerced != nil {
+		b, err := io.ReadAll(body)
+		if err != nil {
+			return nil, err
+		}
+		coercions, err := req.UnmarshalLenient(b)
+		if err != nil {
+			return nil, err
+		}
+		if len(coercions) > 0 {
+			h.Coerced(&req, coercions)
+		}
+		return &req, nil
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+// writeJSON writes v with the status, gzipped if the request accepts it
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if isVersion(r.Header.Get(twosix.Header), twosix.Version) {
+		w.Header().Set(twosix.Header, twosix.Version)
+	} else {
+		w.Header().Set(Header, Version)
+	}
+	if !strings.Contains(r.Header.Get("Accept-Encoding"), EncodingValue) {
+		w.WriteHeader(status)
+		w.Write(b)
+		return
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write(b)
+	zw.Close()
+	w.Header().Set(EncodingHeader, EncodingValue)
+	w.WriteHeader(status)
+	w.Write(buf.Bytes())
+}
+
+// isVersion reports if the version header v is of the version, or one of its patch versions
+func isVersion(v, version string) bool {
+	return v == version || strings.HasPrefix(v, version+".")
+}
+
+// impSize returns the size of the banner of the first imp, or of its video
+func impSize(imps []Imp) (w, h int) {
+	if len(imps) == 0 {
+		return 0, 0
+	}
+	if b := imps[0].Banner; b != nil && b.W > 0 && b.H > 0 {
+		return b.W, b.H
+	}
+	if v := imps[0].Video; v != nil {
+		return v.W, v.H
+	}
+	return 0, 0
+}
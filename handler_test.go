@@ -0,0 +1,164 @@
+package twofive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	bidder := BidderFunc(func(ctx context.Context, req *Request) (*BidResponse, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("bidder called without a deadline")
+		}
+		switch req.Imp[0].TagID {
+		case "nobid":
+			return nil, nil
+		case "unmatched":
+			resp := NoBid(req.ID, NoBidUnmatchedUser)
+			return &resp, nil
+		case "error":
+			return nil, errors.New("bidder failed")
+		}
+		return &BidResponse{SeatBid: []Seatbid{{Bid: []Bid{{ID: "1", ImpID: req.Imp[0].ID, Price: 3}}}}}, nil
+	})
+	srv := httptest.NewServer(NewHandler(bidder))
+	defer srv.Close()
+
+	tests := []struct {
+		name   string
+		mutate func(r *Request)
+		gzip   bool
+		v26    bool
+		noBid  bool
+		nbr    NoBidReason
+		want   ClientErrorKind
+	}{
+		{name: "Bid"},
+		{name: "Gzip", gzip: true},
+		{name: "2.6", v26: true},
+		{name: "2.6 No Format", mutate: func(r *Request) { r.Format = Format{} }, v26: true},
+		{name: "2.6 No Bid Reason", mutate: func(r *Request) { r.Imp[0].TagID = "unmatched" }, v26: true, noBid: true, nbr: NoBidUnmatchedUser},
+		{name: "No Bid", mutate: func(r *Request) { r.Imp[0].TagID = "nobid" }, noBid: true},
+		{name: "No Bid Reason", mutate: func(r *Request) { r.Imp[0].TagID = "unmatched" }, noBid: true, nbr: NoBidUnmatchedUser},
+		{name: "Bidder Error", mutate: func(r *Request) { r.Imp[0].TagID = "error" }, want: ClientBadStatus},
+		{name: "Invalid", mutate: func(r *Request) { r.Ext.APIKey = "" }, want: ClientBadStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := loadValidRequest(t, "./test_data/static_bid_request.json")
+			req.Tmax = 500
+			if tt.mutate != nil {
+				tt.mutate(req)
+			}
+
+			c := &Client{Endpoint: srv.URL, HTTPClient: srv.Client(), Gzip: tt.gzip, V26: tt.v26}
+			resp, err := c.Bid(context.Background(), req)
+			if tt.want != 0 {
+				var cerr *ClientError
+				if !errors.As(err, &cerr) || cerr.Kind != tt.want {
+					t.Fatalf("Bid() = %v, want a %s error", err, tt.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.ID != req.ID || resp.IsNoBid() != tt.noBid || resp.NBR != tt.nbr {
+				t.Errorf("Bid() = %+v", resp)
+			}
+		})
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	h := NewHandler(BidderFunc(func(context.Context, *Request) (*BidResponse, error) { return nil, nil }))
+	valid := validRequestJSON(t, "./test_data/static_bid_request.json")
+
+	tests := []struct {
+		name    string
+		method  string
+		version string
+		body    []byte
+		status  int
+		want    ValidationErrors
+	}{
+		{name: "Valid", method: http.MethodPost, version: Version, body: valid, status: StatusNoBid},
+		{name: "Method", method: http.MethodGet, status: http.StatusMethodNotAllowed},
+		{name: "Version", method: http.MethodPost, version: "3.0", body: valid, status: http.StatusBadRequest},
+		{name: "Bad JSON", method: http.MethodPost, body: []byte(`{"id":`), status: http.StatusBadRequest},
+		{
+			name:   "Invalid",
+			method: http.MethodPost,
+			body:   bytes.Replace(valid, []byte(`"USA"`), []byte(`"US"`), 1),
+			status: http.StatusBadRequest,
+			want:   ValidationErrors{{Path: "device.geo.country", Rule: "ISO3166Alpha3", Value: "US"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/", bytes.NewReader(tt.body))
+			if tt.version != "" {
+				r.Header.Set(Header, tt.version)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.status, w.Body)
+			}
+			if tt.want == nil {
+				return
+			}
+			var got HandlerError
+			if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Errors, tt.want) {
+				t.Errorf("errors = %#v, want %#v", got.Errors, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandlerCoerced(t *testing.T) {
+	valid := validRequestJSON(t, "./test_data/static_bid_request.json")
+
+	var got []Coercion
+	h := NewHandler(BidderFunc(func(context.Context, *Request) (*BidResponse, error) { return nil, nil }))
+	h.Coerced = func(req *Request, coercions []Coercion) { got = coercions }
+
+	tests := []struct {
+		name string
+		body []byte
+		want []Coercion
+	}{
+		{name: "Valid", body: valid},
+		{
+			name: "Coerced",
+			body: bytes.Replace(valid, []byte(`"w": 320`), []byte(`"w": "320"`), 1),
+			want: []Coercion{{Path: "imp[0].banner.w", Value: `"320"`, Type: "int64"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got = nil
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(tt.body)))
+			if w.Code != StatusNoBid {
+				t.Fatalf("status = %d, want %d: %s", w.Code, StatusNoBid, w.Body)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("coercions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// genClone generates a Clone method for every object, and the cloneTo method it's built on that deep copies
+// into a value allocated by the caller, so slices of objects are copied with a single allocation
+func genClone(p *pkg, b *bytes.Buffer) {
+	for _, name := range p.order {
+		s := p.structs[name]
+		fmt.Fprintf(b, "// Clone returns a deep copy of x, sharing no memory with it\n")
+		fmt.Fprintf(b, "func (x *%s) Clone() *%s {\n", name, name)
+		fmt.Fprintf(b, "if x == nil {\nreturn nil\n}\n")
+		fmt.Fprintf(b, "c := new(%s)\nx.cloneTo(c)\nreturn c\n}\n\n", name)
+
+		fmt.Fprintf(b, "func (x *%s) cloneTo(c *%s) {\n*c = *x\n", name, name)
+		for _, f := range s.fields {
+			cloneField(b, f)
+		}
+		fmt.Fprintf(b, "}\n\n")
+	}
+}
+
+func cloneField(b *bytes.Buffer, f field) {
+	x, c := "x."+f.name, "c."+f.name
+	t := f.typ
+	switch {
+	case f.embedded && t.name == extensions:
+		fmt.Fprintf(b, "%s = %s.clone()\n", c, x)
+	case t.kind == object:
+		fmt.Fprintf(b, "%s.cloneTo(&%s)\n", x, c)
+	case t.kind == iface:
+		fmt.Fprintf(b, "%s = cloneJSONValue(%s)\n", c, x)
+	case t.kind == rawMsg:
+		fmt.Fprintf(b, "%s = append(%s[:0:0], %s...)\n", c, x, x)
+	case t.kind == ptr && t.elem.kind == object:
+		fmt.Fprintf(b, "%s = %s.Clone()\n", c, x)
+	case t.kind == ptr && (t.elem.kind == basic || t.elem.kind == enum):
+		fmt.Fprintf(b, "if %s != nil {\nv := *%s\n%s = &v\n}\n", x, x, c)
+	case t.kind == slice && (t.elem.kind == basic || t.elem.kind == enum):
+		// slicing to a zero capacity keeps nil and empty slices apart while forcing append to allocate
+		fmt.Fprintf(b, "%s = append(%s[:0:0], %s...)\n", c, x, x)
+	case t.kind == slice && t.elem.kind == object:
+		fmt.Fprintf(b, "if %s != nil {\n%s = make(%s, len(%s))\n", x, c, t, x)
+		fmt.Fprintf(b, "for i := range %s {\n%s[i].cloneTo(&%s[i])\n}\n}\n", x, x, c)
+	case t.kind == slice && t.elem.kind == iface:
+		fmt.Fprintf(b, "if %s != nil {\n%s = make(%s, len(%s))\n", x, c, t, x)
+		fmt.Fprintf(b, "for i := range %s {\n%s[i] = cloneJSONValue(%s[i])\n}\n}\n", x, c, x)
+	case t.kind == basic || t.kind == enum:
+	default:
+		panic(fmt.Sprintf("clone: unsupported field %s %s", f.name, t))
+	}
+}
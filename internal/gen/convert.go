@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// v26Names are the 2.6 objects named otherwise than the 2.5 objects they're converted to and from
+var v26Names = map[string]string{"Request": "BidRequest"}
+
+// v26Renames are the 2.6 fields named otherwise than the 2.5 fields they're converted to and from, by object
+// and field
+var v26Renames = map[string]string{"Imp.DisplayManagerServer": "DisplayManagerVer"}
+
+// unconverted are the fields of the same name that aren't converted, as their values mean different things
+var unconverted = map[string]bool{"Deal.BidFloorCur": true} // a float in 2.5
+
+// byHand are the fields converted by hand: the 2.6 fields the 2.5 objects carry in their ext, and the 2.5
+// fields 2.6 carries elsewhere
+var byHand = map[string]bool{
+	"Request.Format": true,
+	"Regs.GDPR":      true,
+	"Regs.USPrivacy": true,
+	"Source.SChain":  true,
+	"Imp.Rwdd":       true,
+	"Video.Plcmt":    true,
+	"User.Consent":   true,
+	"User.EIDs":      true,
+}
+
+// genConvert generates the toV26 and fromV26 methods of every object of p that has a 2.6 counterpart in v26,
+// converting the fields of the same name, case insensitively. A field of either without a counterpart is
+// reported lost when it's set, as is a value that doesn't convert
+func genConvert(p, v26 *pkg, b *bytes.Buffer) {
+	fmt.Fprintf(b, "import (\n\"strconv\"\n\"strings\"\n\n\"github.com/timehop/ortb-twofive/%s\"\n)\n\n", v26Dir)
+	for _, to := range []bool{true, false} {
+		g := &convGen{p: p, v26: v26, b: b, to: to}
+		for _, name := range p.order {
+			if s, t := p.structs[name], v26.structs[v26Name(name)]; t != nil && !s.ext {
+				g.genObject(s, t)
+			}
+		}
+	}
+}
+
+func v26Name(name string) string {
+	if n, ok := v26Names[name]; ok {
+		return n
+	}
+	return name
+}
+
+// convGen generates the conversions of a direction, to 2.6 or from it. The source is x and the converted
+// value c, x being the 2.5 object when converting to 2.6 and c when converting from it
+type convGen struct {
+	p, v26 *pkg
+	b      *bytes.Buffer
+	to     bool
+}
+
+func (g *convGen) genObject(s, t *structType) {
+	fields := make(map[string]jsonField)
+	for _, f := range jsonFields(t) {
+		fields[strings.ToLower(f.field.name)] = f
+	}
+	pairs := make(map[string]jsonField) // the 2.6 field of each 2.5 field by its name
+	for _, f := range jsonFields(s) {
+		name := f.field.name
+		if n, ok := v26Renames[s.name+"."+name]; ok {
+			name = n
+		}
+		if n, ok := fields[strings.ToLower(name)]; ok && !unconverted[t.name+"."+n.field.name] {
+			pairs[f.field.name] = n
+		}
+	}
+
+	if g.to {
+		fmt.Fprintf(g.b, "// toV26 converts x to c\n")
+		fmt.Fprintf(g.b, "func (x *%s) toV26(c *%s.%s, l *conversion, path string) {\n", s.name, v26Dir, t.name)
+		for _, f := range jsonFields(s) {
+			if byHand[s.name+"."+f.field.name] {
+				continue
+			}
+			n, ok := pairs[f.field.name]
+			path := fmt.Sprintf("path+%q", "."+f.name)
+			if !ok || !g.convert("x."+f.field.name, "c."+n.field.name, f.typ, n.typ, path) {
+				fmt.Fprintf(g.b, "if !isZero(x.%s) {\nl.lose(%s, \"no 2.6 field\")\n}\n", f.field.name, path)
+			}
+		}
+	} else {
+		converted := make(map[string]bool)
+		fmt.Fprintf(g.b, "// fromV26 converts x to c\n")
+		fmt.Fprintf(g.b, "func (c *%s) fromV26(x *%s.%s, l *conversion, path string) {\n", s.name, v26Dir, t.name)
+		for _, f := range jsonFields(s) {
+			if n, ok := pairs[f.field.name]; ok {
+				path := fmt.Sprintf("path+%q", "."+n.name)
+				converted[n.field.name] = g.convert("x."+n.field.name, "c."+f.field.name, n.typ, f.typ, path)
+			}
+		}
+		for _, n := range jsonFields(t) {
+			if !converted[n.field.name] && !byHand[t.name+"."+n.field.name] {
+				fmt.Fprintf(g.b, "if !isZero(x.%s) {\nl.lose(path+%q, \"no 2.5 field\")\n}\n", n.field.name, "."+n.name)
+			}
+		}
+	}
+	fmt.Fprintf(g.b, "}\n\n")
+}
+
+// typeName returns the name of t in the generated code, qualified by the package of the 2.6 objects if it's one
+func (g *convGen) typeName(t *typ, v26 bool) string {
+	switch t.kind {
+	case ptr:
+		return "*" + g.typeName(t.elem, v26)
+	case slice:
+		return "[]" + g.typeName(t.elem, v26)
+	case object:
+		if v26 {
+			return v26Dir + "." + t.name
+		}
+	}
+	return t.String()
+}
+
+// objectOf returns the object t is or points to
+func objectOf(t *typ) (name string, isPtr bool) {
+	if t.kind == ptr {
+		t, isPtr = t.elem, true
+	}
+	if t.kind != object {
+		return "", false
+	}
+	return t.name, isPtr
+}
+
+func scalar(t *typ) bool {
+	return t.kind == basic || t.kind == enum
+}
+
+// paired reports if the objects of the source and the converted value are converted to each other
+func (g *convGen) paired(src, dst string) bool {
+	name, other := src, dst
+	if !g.to {
+		name, other = dst, src
+	}
+	s, ok := g.p.structs[name]
+	return ok && !s.ext && v26Name(name) == other
+}
+
+// convert generates the conversion of the src value of type st to the dst of type dt, and reports if there's
+// one. path is the expression of the JSON path of the value
+func (g *convGen) convert(src, dst string, st, dt *typ, path string) bool {
+	srcObj, srcPtr := objectOf(st)
+	dstObj, dstPtr := objectOf(dt)
+	dstType := g.typeName(dt, g.to)
+	b := g.b
+
+	switch {
+	case scalar(st) && scalar(dt) && st.basic == dt.basic:
+		if st.name == dt.name {
+			fmt.Fprintf(b, "%s = %s\n", dst, src)
+		} else {
+			fmt.Fprintf(b, "%s = %s(%s)\n", dst, dstType, src)
+		}
+	case scalar(st) && scalar(dt) && st.basic == "string" && dt.basic == "float64":
+		fmt.Fprintf(b, "%s = l.parseFloat(%s, %s)\n", dst, path, src)
+	case scalar(st) && scalar(dt) && st.basic == "float64" && dt.basic == "string":
+		fmt.Fprintf(b, "if %s != 0 {\n%s = strconv.FormatFloat(%s, 'f', -1, 64)\n}\n", src, dst, src)
+	case st.kind == slice && st.elem.kind == basic && st.elem.basic == "string" && dt.kind == basic && dt.basic == "string":
+		fmt.Fprintf(b, "%s = strings.Join(%s, \",\")\n", dst, src)
+	case st.kind == basic && st.basic == "string" && dt.kind == slice && dt.elem.kind == basic && dt.elem.basic == "string":
+		fmt.Fprintf(b, "if %s != \"\" {\n%s = strings.Split(%s, \",\")\n}\n", src, dst, src)
+	case st.kind == ptr && dt.kind == ptr && scalar(st.elem) && scalar(dt.elem) && st.elem.basic == dt.elem.basic:
+		fmt.Fprintf(b, "if %s != nil {\nv := %s(*%s)\n%s = &v\n}\n", src, g.typeName(dt.elem, g.to), src, dst)
+	case st.kind == slice && dt.kind == slice && scalar(st.elem) && scalar(dt.elem) && st.elem.name == dt.elem.name:
+		fmt.Fprintf(b, "if %s != nil {\n%s = append(%s(nil), %s...)\n}\n", src, dst, dstType, src)
+	case st.kind == slice && dt.kind == slice && scalar(st.elem) && scalar(dt.elem) && st.elem.basic == dt.elem.basic:
+		fmt.Fprintf(b, "if %s != nil {\n%s = make(%s, len(%s))\n", src, dst, dstType, src)
+		fmt.Fprintf(b, "for i, v := range %s {\n%s[i] = %s(v)\n}\n}\n", src, dst, g.typeName(dt.elem, g.to))
+	case srcObj != "" && dstObj != "" && g.paired(srcObj, dstObj):
+		switch {
+		case srcPtr:
+			fmt.Fprintf(b, "if %s != nil {\n", src)
+		case dstPtr:
+			fmt.Fprintf(b, "if !isZero(%s) {\n", src)
+		}
+		if dstPtr {
+			fmt.Fprintf(b, "%s = new(%s)\n", dst, g.typeName(dt.elem, g.to))
+		}
+		g.call(src, dst, srcPtr, dstPtr, path)
+		if srcPtr || dstPtr {
+			fmt.Fprintf(b, "}\n")
+		}
+	case st.kind == slice && dt.kind == slice && st.elem.kind == object && dt.elem.kind == object &&
+		g.paired(st.elem.name, dt.elem.name):
+		fmt.Fprintf(b, "if %s != nil {\n%s = make(%s, len(%s))\n", src, dst, dstType, src)
+		fmt.Fprintf(b, "for i := range %s {\n", src)
+		g.call(src+"[i]", dst+"[i]", false, false, path+`+"["+strconv.Itoa(i)+"]"`)
+		fmt.Fprintf(b, "}\n}\n")
+	case g.to && srcObj != "" && g.p.structs[srcObj].ext && dt.kind == rawMsg:
+		if srcPtr {
+			fmt.Fprintf(b, "if %s != nil {\n%s = l.marshalExt(%s, %s)\n}\n", src, dst, path, src)
+		} else {
+			fmt.Fprintf(b, "%s = l.marshalExt(%s, &%s)\n", dst, path, src)
+		}
+	case !g.to && st.kind == rawMsg && dstObj != "" && g.p.structs[dstObj].ext:
+		if dstPtr {
+			fmt.Fprintf(b, "if len(%s) > 0 {\n%s = new(%s)\nl.unmarshalExt(%s, %s, %s)\n}\n", src, dst, dstObj, path, src, dst)
+		} else {
+			fmt.Fprintf(b, "if len(%s) > 0 {\nl.unmarshalExt(%s, %s, &%s)\n}\n", src, path, src, dst)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// call generates the call converting the src object to the dst one
+func (g *convGen) call(src, dst string, srcPtr, dstPtr bool, path string) {
+	if g.to {
+		if !dstPtr {
+			dst = "&" + dst
+		}
+		fmt.Fprintf(g.b, "%s.toV26(%s, l, %s)\n", src, dst, path)
+		return
+	}
+	if !srcPtr {
+		src = "&" + src
+	}
+	fmt.Fprintf(g.b, "%s.fromV26(%s, l, %s)\n", dst, src, path)
+}
@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// jsonField is a field as encoding/json sees it
+type jsonField struct {
+	field
+	name      string
+	omitEmpty bool
+}
+
+// jsonFields returns the fields encoding/json encodes, with the names and options of their tags
+func jsonFields(s *structType) []jsonField {
+	var fields []jsonField
+	names := make(map[string]string)
+	for _, f := range s.fields {
+		tag := reflect.StructTag(f.tag).Get("json")
+		if tag == "-" {
+			continue
+		}
+		if f.embedded {
+			panic(fmt.Sprintf("json: embedded field %s.%s", s.name, f.name))
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.name
+		}
+		for _, o := range strings.Split(opts, ",") {
+			if o == "string" {
+				panic(fmt.Sprintf("json: the string option of %s.%s", s.name, f.name))
+			}
+		}
+
+		// the generated decoder relies on the names not folding alike
+		folded := fold(name)
+		if other, ok := names[folded]; ok {
+			panic(fmt.Sprintf("json: %s.%s and %s fold to the same name", s.name, f.name, other))
+		}
+		names[folded] = f.name
+		fields = append(fields, jsonField{field: f, name: name, omitEmpty: strings.Contains(","+opts+",", ",omitempty,")})
+	}
+	return fields
+}
+
+// genJSON generates the MarshalJSON and UnmarshalJSON methods of every object without its own, and the
+// writeJSON and readJSON methods they're built on that nested objects are encoded and decoded with
+func genJSON(p *pkg, b *bytes.Buffer) {
+	for _, name := range p.order {
+		s := p.structs[name]
+		if s.marshaler {
+			continue
+		}
+		fields := jsonFields(s)
+
+		fmt.Fprintf(b, "// MarshalJSON encodes x as encoding/json does from its tags\n")
+		fmt.Fprintf(b, "func (x %s) MarshalJSON() ([]byte, error) {\n", name)
+		fmt.Fprintf(b, "w := jsonWriter{buf: make([]byte, 0, %d)}\n", 64*len(fields))
+		fmt.Fprintf(b, "x.writeJSON(&w)\nreturn w.buf, w.err\n}\n\n")
+
+		fmt.Fprintf(b, "func (x *%s) writeJSON(w *jsonWriter) {\nw.buf = append(w.buf, '{')\n", name)
+		for _, f := range fields {
+			key := quote(jsonName(f.name) + ":")
+			target := "x." + f.field.name
+			if f.omitEmpty {
+				if cond := nonEmpty(target, f.typ); cond != "" {
+					fmt.Fprintf(b, "if %s {\nw.field(%s)\n", cond, key)
+					writeValue(p, b, target, f.typ, true)
+					fmt.Fprintf(b, "}\n")
+					continue
+				}
+			}
+			fmt.Fprintf(b, "w.field(%s)\n", key)
+			writeValue(p, b, target, f.typ, false)
+		}
+		if s.ext {
+			fmt.Fprintf(b, "w.extensions(x.Extensions, %s)\n", extFields(fields))
+		}
+		fmt.Fprintf(b, "w.buf = append(w.buf, '}')\n}\n\n")
+
+		fmt.Fprintf(b, "// UnmarshalJSON decodes x as encoding/json does from its tags\n")
+		fmt.Fprintf(b, "func (x *%s) UnmarshalJSON(b []byte) error {\n", name)
+		fmt.Fprintf(b, "r := jsonReader{data: b}\nx.readJSON(&r)\nreturn r.done()\n}\n\n")
+
+		fmt.Fprintf(b, "func (x *%s) readJSON(r *jsonReader) {\n", name)
+		if s.ext {
+			fmt.Fprintf(b, "r.extensions(&x.Extensions)\n")
+		}
+		fmt.Fprintf(b, "for key, ok := r.object(x); ok; key, ok = r.next() {\nname := key\nmatch:\nswitch string(name) {\n")
+		for _, f := range fields {
+			labels := strconv.Quote(f.name)
+			if folded := fold(f.name); folded != f.name {
+				labels += ", " + strconv.Quote(folded)
+			}
+			fmt.Fprintf(b, "case %s:\n", labels)
+			readValue(p, b, "x."+f.field.name, f.typ)
+		}
+		fmt.Fprintf(b, "default:\n// keys match fields case insensitively, as in encoding/json\n")
+		fmt.Fprintf(b, "if name = foldKey(name); name != nil {\ngoto match\n}\n")
+		if s.ext {
+			fmt.Fprintf(b, "r.extension(&x.Extensions, x, key)\n}\n}\n}\n\n")
+		} else {
+			fmt.Fprintf(b, "r.skip()\n}\n}\n}\n\n")
+		}
+	}
+}
+
+// extFields returns the names of the modelled fields of an ext type as a slice literal
+func extFields(fields []jsonField) string {
+	if len(fields) == 0 {
+		return "nil"
+	}
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = strconv.Quote(f.name)
+	}
+	return "[]string{" + strings.Join(names, ", ") + "}"
+}
+
+func nonEmpty(target string, t *typ) string {
+	switch t.kind {
+	case basic, enum:
+		switch {
+		case t.basic == "string":
+			return target + ` != ""`
+		case t.basic == "bool":
+			return target
+		}
+		return target + " != 0"
+	case ptr, iface:
+		return target + " != nil"
+	case slice, rawMsg:
+		return "len(" + target + ") != 0"
+	}
+	return ""
+}
+
+// writeValue writes the code encoding target, nonNil when it's known not to be nil
+func writeValue(p *pkg, b *bytes.Buffer, target string, t *typ, nonNil bool) {
+	switch t.kind {
+	case basic, enum:
+		switch kind, bits := numeric(t.basic); kind {
+		case "string", "bool":
+			fmt.Fprintf(b, "w.%s(%s(%s))\n", kind, kind, target)
+		case "int", "uint":
+			fmt.Fprintf(b, "w.%s(%s64(%s))\n", kind, kind, target)
+		case "float":
+			fmt.Fprintf(b, "w.float(float64(%s), %s)\n", target, bits)
+		}
+	case object:
+		if p.structs[t.name].marshaler {
+			fmt.Fprintf(b, "w.marshaler(&%s)\n", target)
+			return
+		}
+		fmt.Fprintf(b, "%s.writeJSON(w)\n", target)
+	case ptr:
+		if !nonNil {
+			fmt.Fprintf(b, "if %s == nil {\nw.null()\n} else {\n", target)
+			defer fmt.Fprintf(b, "}\n")
+		}
+		switch {
+		case t.elem.kind == object && p.structs[t.elem.name].marshaler:
+			fmt.Fprintf(b, "w.marshaler(%s)\n", target)
+		case t.elem.kind == object:
+			fmt.Fprintf(b, "%s.writeJSON(w)\n", target)
+		default:
+			writeValue(p, b, "*"+target, t.elem, false)
+		}
+	case slice:
+		if t.elem.kind == slice {
+			panic("json: slices of slices")
+		}
+		if !nonNil {
+			fmt.Fprintf(b, "if %s == nil {\nw.null()\n} else {\n", target)
+			defer fmt.Fprintf(b, "}\n")
+		}
+		fmt.Fprintf(b, "w.buf = append(w.buf, '[')\nfor i := range %s {\nw.elem(i)\n", target)
+		writeValue(p, b, target+"[i]", t.elem, false)
+		fmt.Fprintf(b, "}\nw.buf = append(w.buf, ']')\n")
+	case iface:
+		fmt.Fprintf(b, "w.value(%s)\n", target)
+	case rawMsg:
+		fmt.Fprintf(b, "w.raw(%s)\n", target)
+	}
+}
+
+func readValue(p *pkg, b *bytes.Buffer, target string, t *typ) {
+	switch t.kind {
+	case basic, enum:
+		kind, bits := numeric(t.basic)
+		v := "v"
+		if t.name != kind+bits {
+			v = t.name + "(v)"
+		}
+		fmt.Fprintf(b, "if v, ok := r.%s(%s); ok {\n%s = %s\n}\n", kind, bits, target, v)
+	case object:
+		if p.structs[t.name].marshaler {
+			fmt.Fprintf(b, "r.unmarshaler(&%s)\n", target)
+			return
+		}
+		fmt.Fprintf(b, "%s.readJSON(r)\n", target)
+	case ptr:
+		// like encoding/json, a null sets the pointer to nil and anything else is decoded into what it points to
+		fmt.Fprintf(b, "if r.null() {\n%s = nil\n} else {\n", target)
+		if t.elem.kind == object {
+			fmt.Fprintf(b, "if %s == nil {\n%s = %s.Get().(%s)\n}\n", target, target, objectPool(t.elem.name), t)
+		} else {
+			fmt.Fprintf(b, "if %s == nil {\n%s = new(%s)\n}\n", target, target, t.elem)
+		}
+		switch {
+		case t.elem.kind == object && p.structs[t.elem.name].marshaler:
+			fmt.Fprintf(b, "r.unmarshaler(%s)\n", target)
+		case t.elem.kind == object:
+			fmt.Fprintf(b, "%s.readJSON(r)\n", target)
+		default:
+			readValue(p, b, "*"+target, t.elem)
+		}
+		fmt.Fprintf(b, "}\n")
+	case slice:
+		fmt.Fprintf(b, "if r.null() {\n%s = nil\n} else if r.array(&%s) {\n", target, target)
+		fmt.Fprintf(b, "if %s == nil {\n%s = %s.get()\n}\n", target, target, slicePool(t.elem))
+		fmt.Fprintf(b, "i := 0\nfor ; r.elem(i); i++ {\n%s = grow(%s, i)\n", target, target)
+		readValue(p, b, target+"[i]", t.elem)
+		fmt.Fprintf(b, "}\n%s = truncate(%s, i)\n}\n", target, target)
+	case iface:
+		fmt.Fprintf(b, "r.value(&%s)\n", target)
+	case rawMsg:
+		fmt.Fprintf(b, "r.rawMessage(&%s)\n", target)
+	}
+}
+
+// numeric returns the reader and writer method of a basic type, and its size if it's a number
+func numeric(basic string) (kind, bits string) {
+	switch basic {
+	case "string", "bool":
+		return basic, ""
+	case "int", "uint":
+		return basic, "intSize"
+	case "float32", "float64":
+		return "float", basic[5:]
+	}
+	if strings.HasPrefix(basic, "uint") {
+		return "uint", basic[4:]
+	}
+	return "int", basic[3:]
+}
+
+// quote returns s as a Go string literal, raw if it can be
+func quote(s string) string {
+	if !strconv.CanBackquote(s) {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// jsonName returns the name quoted as encoding/json writes it
+func jsonName(name string) string {
+	b, err := json.Marshal(name)
+	if err != nil {
+		panic(err)
+	}
+	return string(b)
+}
+
+// fold folds the name as encoding/json does to match keys case insensitively
+func fold(name string) string {
+	var out []byte
+	for _, r := range name {
+		if r < utf8.RuneSelf {
+			if 'a' <= r && r <= 'z' {
+				r -= 'a' - 'A'
+			}
+			out = append(out, byte(r))
+			continue
+		}
+		for {
+			r2 := unicode.SimpleFold(r)
+			if r2 <= r {
+				r = r2
+				break
+			}
+			r = r2
+		}
+		out = utf8.AppendRune(out, r)
+	}
+	return string(out)
+}
@@ -0,0 +1,289 @@
+// Command gen generates the methods of the OpenRTB objects that would otherwise be written by hand for every
+// type, from the struct declarations of the package. It's run by go generate from the package directory
+//
+//	go generate ./...
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by internal/gen; DO NOT EDIT.\n\n"
+
+// roots are the objects everything generated is reachable from
+var roots = []string{"Request", "BidResponse", "NativeRequest", "NativeResponse"}
+
+// v26Dir is the package of the OpenRTB 2.6 objects the requests are converted to and from, and v26Root its
+// request
+const (
+	v26Dir  = "twosix"
+	v26Root = "BidRequest"
+)
+
+// extensions is the type embedded in every ext type, its fields are unexported and handled by hand
+const extensions = "Extensions"
+
+type kind int
+
+const (
+	basic  kind = iota // string, bool and the numeric types
+	enum               // a named type of a basic type
+	object             // a named struct type
+	ptr
+	slice
+	iface  // interface{}
+	rawMsg // json.RawMessage
+)
+
+type typ struct {
+	kind  kind
+	name  string // of basic, enum and object types
+	basic string // the basic type of basic and enum types
+	elem  *typ   // of ptr and slice types
+}
+
+func (t *typ) String() string {
+	switch t.kind {
+	case ptr:
+		return "*" + t.elem.String()
+	case slice:
+		return "[]" + t.elem.String()
+	case iface:
+		return "interface{}"
+	case rawMsg:
+		return "json.RawMessage"
+	}
+	return t.name
+}
+
+type field struct {
+	name     string
+	typ      *typ
+	tag      string // the raw struct tag, without its quotes
+	embedded bool
+}
+
+type structType struct {
+	name      string
+	fields    []field
+	ext       bool // embeds Extensions
+	marshaler bool // has its own MarshalJSON and UnmarshalJSON
+}
+
+// pkg is the model of the package the code is generated for
+type pkg struct {
+	name    string
+	enums   map[string]string // enum name to its basic type
+	structs map[string]*structType
+	order   []string // reachable struct names, sorted
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+
+	p, err := load(".", roots...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	v26, err := load(v26Dir, v26Root)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for file, gen := range map[string]func(p *pkg, b *bytes.Buffer){
+		"clone_gen.go":   genClone,
+		"json_gen.go":    genJSON,
+		"reset_gen.go":   genReset,
+		"convert_gen.go": func(p *pkg, b *bytes.Buffer) { genConvert(p, v26, b) },
+	} {
+		if err := write(p, file, gen); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func write(p *pkg, file string, gen func(p *pkg, b *bytes.Buffer)) error {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package " + p.name + "\n\n")
+	gen(p, &b)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %v\n%s", file, err, b.Bytes())
+	}
+	return ioutil.WriteFile(file, src, 0o644)
+}
+
+// load parses the package in dir, skipping tests and generated files, and resolves the types reachable from
+// the roots
+func load(dir string, roots ...string) (*pkg, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), "_gen.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("want one package in %s, found %d", dir, len(pkgs))
+	}
+
+	p := &pkg{enums: make(map[string]string), structs: make(map[string]*structType)}
+	decls := make(map[string]*ast.StructType)
+	marshalers := make(map[string]bool)
+	for name, astPkg := range pkgs {
+		p.name = name
+		for _, f := range astPkg.Files {
+			for _, d := range f.Decls {
+				switch d := d.(type) {
+				case *ast.GenDecl:
+					for _, s := range d.Specs {
+						ts, ok := s.(*ast.TypeSpec)
+						if !ok || ts.TypeParams != nil {
+							continue
+						}
+						switch t := ts.Type.(type) {
+						case *ast.StructType:
+							decls[ts.Name.Name] = t
+						case *ast.Ident:
+							if isBasic(t.Name) {
+								p.enums[ts.Name.Name] = t.Name
+							}
+						}
+					}
+				case *ast.FuncDecl:
+					if d.Recv != nil && d.Name.Name == "MarshalJSON" {
+						marshalers[recvName(d.Recv.List[0].Type)] = true
+					}
+				}
+			}
+		}
+	}
+
+	var resolve func(name string) error
+	resolve = func(name string) error {
+		if _, ok := p.structs[name]; ok || name == extensions {
+			return nil
+		}
+		decl, ok := decls[name]
+		if !ok {
+			return fmt.Errorf("struct %s not found", name)
+		}
+		s := &structType{name: name, marshaler: marshalers[name]}
+		p.structs[name] = s
+
+		for _, f := range decl.Fields.List {
+			t, err := p.typeOf(f.Type, decls)
+			if err != nil {
+				return fmt.Errorf("%s: %v", name, err)
+			}
+			var tag string
+			if f.Tag != nil {
+				if tag, err = strconv.Unquote(f.Tag.Value); err != nil {
+					return err
+				}
+			}
+			if len(f.Names) == 0 {
+				s.fields = append(s.fields, field{name: t.name, typ: t, tag: tag, embedded: true})
+				s.ext = s.ext || t.name == extensions
+			}
+			for _, n := range f.Names {
+				if n.IsExported() {
+					s.fields = append(s.fields, field{name: n.Name, typ: t, tag: tag})
+				}
+			}
+		}
+
+		for _, f := range s.fields {
+			for t := f.typ; t != nil; t = t.elem {
+				if t.kind == object {
+					if err := resolve(t.name); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	for _, r := range roots {
+		if err := resolve(r); err != nil {
+			return nil, err
+		}
+	}
+
+	for name := range p.structs {
+		p.order = append(p.order, name)
+	}
+	sort.Strings(p.order)
+	return p, nil
+}
+
+func (p *pkg) typeOf(e ast.Expr, decls map[string]*ast.StructType) (*typ, error) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if isBasic(e.Name) {
+			return &typ{kind: basic, name: e.Name, basic: e.Name}, nil
+		}
+		if b, ok := p.enums[e.Name]; ok {
+			return &typ{kind: enum, name: e.Name, basic: b}, nil
+		}
+		if _, ok := decls[e.Name]; ok {
+			return &typ{kind: object, name: e.Name}, nil
+		}
+	case *ast.StarExpr:
+		elem, err := p.typeOf(e.X, decls)
+		if err != nil {
+			return nil, err
+		}
+		return &typ{kind: ptr, elem: elem}, nil
+	case *ast.ArrayType:
+		if e.Len != nil {
+			break
+		}
+		elem, err := p.typeOf(e.Elt, decls)
+		if err != nil {
+			return nil, err
+		}
+		return &typ{kind: slice, elem: elem}, nil
+	case *ast.InterfaceType:
+		if len(e.Methods.List) == 0 {
+			return &typ{kind: iface}, nil
+		}
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "json" && e.Sel.Name == "RawMessage" {
+			return &typ{kind: rawMsg}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported type %T", e)
+}
+
+func isBasic(name string) bool {
+	switch name {
+	case "string", "bool", "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return true
+	}
+	return false
+}
+
+func recvName(e ast.Expr) string {
+	if s, ok := e.(*ast.StarExpr); ok {
+		e = s.X
+	}
+	if id, ok := e.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// genReset generates a Reset method for every object, and the pools it returns what the object points to into,
+// that the generated decoder allocates from
+func genReset(p *pkg, b *bytes.Buffer) {
+	objects, slices := pooled(p)
+
+	fmt.Fprintf(b, "import \"sync\"\n\n")
+	fmt.Fprintf(b, "// the pools of the objects pointed to and the backing arrays of slices, reused by the decoder\n")
+	fmt.Fprintf(b, "var (\n")
+	for _, name := range objects {
+		fmt.Fprintf(b, "%s = sync.Pool{New: func() interface{} { return new(%s) }}\n", objectPool(name), name)
+	}
+	for _, t := range slices {
+		fmt.Fprintf(b, "%s slicePool[%s]\n", slicePool(t), t)
+	}
+	fmt.Fprintf(b, ")\n\n")
+
+	for _, name := range p.order {
+		s := p.structs[name]
+		fmt.Fprintf(b, "// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed\n")
+		fmt.Fprintf(b, "// to may be used after\n")
+		fmt.Fprintf(b, "func (x *%s) Reset() {\n", name)
+		for _, f := range s.fields {
+			resetField(b, f)
+		}
+		fmt.Fprintf(b, "*x = %s{}\n}\n\n", name)
+	}
+}
+
+func resetField(b *bytes.Buffer, f field) {
+	x := "x." + f.name
+	t := f.typ
+	switch {
+	case t.kind == object && !f.embedded:
+		fmt.Fprintf(b, "%s.Reset()\n", x)
+	case t.kind == ptr && t.elem.kind == object:
+		fmt.Fprintf(b, "if %s != nil {\n%s.Reset()\n%s.Put(%s)\n}\n", x, x, objectPool(t.elem.name), x)
+	case t.kind == slice && t.elem.kind == object:
+		fmt.Fprintf(b, "if %s != nil {\n", x)
+		fmt.Fprintf(b, "for i, s := 0, %s[:cap(%s)]; i < len(s); i++ {\ns[i].Reset()\n}\n", x, x)
+		fmt.Fprintf(b, "%s.put(%s)\n}\n", slicePool(t.elem), x)
+	case t.kind == slice:
+		fmt.Fprintf(b, "%s.put(%s)\n", slicePool(t.elem), x)
+	}
+}
+
+// pooled returns the objects pointed to and the element types of slices, sorted by name
+func pooled(p *pkg) (objects []string, slices []*typ) {
+	seenObjects := make(map[string]bool)
+	seenSlices := make(map[string]bool)
+	for _, name := range p.order {
+		for _, f := range p.structs[name].fields {
+			t := f.typ
+			switch {
+			case t.kind == ptr && t.elem.kind == object && !seenObjects[t.elem.name]:
+				seenObjects[t.elem.name] = true
+				objects = append(objects, t.elem.name)
+			case t.kind == slice && !seenSlices[t.elem.String()]:
+				seenSlices[t.elem.String()] = true
+				slices = append(slices, t.elem)
+			}
+		}
+	}
+	sort.Strings(objects)
+	sort.Slice(slices, func(i, j int) bool { return slices[i].String() < slices[j].String() })
+	return objects, slices
+}
+
+func objectPool(name string) string {
+	return "pool" + name
+}
+
+func slicePool(elem *typ) string {
+	var name string
+	switch elem.kind {
+	case iface:
+		name = "Interface"
+	case rawMsg:
+		name = "RawMessage"
+	default:
+		name = strings.ToUpper(elem.name[:1]) + elem.name[1:]
+	}
+	return "pool" + name + "Slice"
+}
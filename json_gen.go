@@ -0,0 +1,6758 @@
+// Code generated by internal/gen; DO NOT EDIT.
+
+package twofive
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x App) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 960)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *App) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	w.field(`"name":`)
+	w.string(string(x.Name))
+	w.field(`"bundle":`)
+	w.string(string(x.Bundle))
+	w.field(`"domain":`)
+	w.string(string(x.Domain))
+	w.field(`"storeurl":`)
+	w.string(string(x.StoreURL))
+	if len(x.Cat) != 0 {
+		w.field(`"cat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.SectionCat) != 0 {
+		w.field(`"sectioncat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.SectionCat {
+			w.elem(i)
+			w.string(string(x.SectionCat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.PageCat) != 0 {
+		w.field(`"pagecat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.PageCat {
+			w.elem(i)
+			w.string(string(x.PageCat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ver != "" {
+		w.field(`"ver":`)
+		w.string(string(x.Ver))
+	}
+	w.field(`"privacypolicy":`)
+	w.int(int64(x.PrivacyPolicy))
+	w.field(`"paid":`)
+	w.int(int64(x.Paid))
+	w.field(`"publisher":`)
+	x.Publisher.writeJSON(w)
+	if x.Content != nil {
+		w.field(`"content":`)
+		x.Content.writeJSON(w)
+	}
+	if len(x.Keywords) != 0 {
+		w.field(`"keywords":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Keywords {
+			w.elem(i)
+			w.string(string(x.Keywords[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *App) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *App) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "bundle", "BUNDLE":
+			if v, ok := r.string(); ok {
+				x.Bundle = v
+			}
+		case "domain", "DOMAIN":
+			if v, ok := r.string(); ok {
+				x.Domain = v
+			}
+		case "storeurl", "STOREURL":
+			if v, ok := r.string(); ok {
+				x.StoreURL = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "sectioncat", "SECTIONCAT":
+			if r.null() {
+				x.SectionCat = nil
+			} else if r.array(&x.SectionCat) {
+				if x.SectionCat == nil {
+					x.SectionCat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.SectionCat = grow(x.SectionCat, i)
+					if v, ok := r.string(); ok {
+						x.SectionCat[i] = v
+					}
+				}
+				x.SectionCat = truncate(x.SectionCat, i)
+			}
+		case "pagecat", "PAGECAT":
+			if r.null() {
+				x.PageCat = nil
+			} else if r.array(&x.PageCat) {
+				if x.PageCat == nil {
+					x.PageCat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.PageCat = grow(x.PageCat, i)
+					if v, ok := r.string(); ok {
+						x.PageCat[i] = v
+					}
+				}
+				x.PageCat = truncate(x.PageCat, i)
+			}
+		case "ver", "VER":
+			if v, ok := r.string(); ok {
+				x.Ver = v
+			}
+		case "privacypolicy", "PRIVACYPOLICY":
+			if v, ok := r.int(intSize); ok {
+				x.PrivacyPolicy = int(v)
+			}
+		case "paid", "PAID":
+			if v, ok := r.int(intSize); ok {
+				x.Paid = int(v)
+			}
+		case "publisher", "PUBLISHER":
+			x.Publisher.readJSON(r)
+		case "content", "CONTENT":
+			if r.null() {
+				x.Content = nil
+			} else {
+				if x.Content == nil {
+					x.Content = poolContent.Get().(*Content)
+				}
+				x.Content.readJSON(r)
+			}
+		case "keywords", "KEYWORDS":
+			if r.null() {
+				x.Keywords = nil
+			} else if r.array(&x.Keywords) {
+				if x.Keywords == nil {
+					x.Keywords = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Keywords = grow(x.Keywords, i)
+					if v, ok := r.string(); ok {
+						x.Keywords[i] = v
+					}
+				}
+				x.Keywords = truncate(x.Keywords, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolAppExt.Get().(*AppExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x AppExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *AppExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *AppExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *AppExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Audio) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1216)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Audio) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if len(x.Mimes) != 0 {
+		w.field(`"mimes":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Mimes {
+			w.elem(i)
+			w.string(string(x.Mimes[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"minduration":`)
+	w.int(int64(x.Minduration))
+	if x.Maxduration != 0 {
+		w.field(`"maxduration":`)
+		w.int(int64(x.Maxduration))
+	}
+	if len(x.Protocols) != 0 {
+		w.field(`"protocols":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Protocols {
+			w.elem(i)
+			w.int(int64(x.Protocols[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"startdelay":`)
+	w.int(int64(x.StartDelay))
+	if x.Sequence != 0 {
+		w.field(`"sequence":`)
+		w.int(int64(x.Sequence))
+	}
+	if len(x.Battr) != 0 {
+		w.field(`"battr":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Battr {
+			w.elem(i)
+			w.int(int64(x.Battr[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.MaxExtended != 0 {
+		w.field(`"maxextended":`)
+		w.int(int64(x.MaxExtended))
+	}
+	if x.MinBitRate != 0 {
+		w.field(`"minbitrate":`)
+		w.int(int64(x.MinBitRate))
+	}
+	if x.MaxBitRate != 0 {
+		w.field(`"maxbitrate":`)
+		w.int(int64(x.MaxBitRate))
+	}
+	if len(x.Delivery) != 0 {
+		w.field(`"delivery":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Delivery {
+			w.elem(i)
+			w.int(int64(x.Delivery[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.CompanionAd) != 0 {
+		w.field(`"companionad":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.CompanionAd {
+			w.elem(i)
+			x.CompanionAd[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.API) != 0 {
+		w.field(`"api":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.API {
+			w.elem(i)
+			w.int(int64(x.API[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.CompanionType) != 0 {
+		w.field(`"companiontype":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.CompanionType {
+			w.elem(i)
+			w.int(int64(x.CompanionType[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Maxseq != 0 {
+		w.field(`"maxseq":`)
+		w.int(int64(x.Maxseq))
+	}
+	if x.Feed != 0 {
+		w.field(`"feed":`)
+		w.int(int64(x.Feed))
+	}
+	if x.Stitched != 0 {
+		w.field(`"stitched":`)
+		w.int(int64(x.Stitched))
+	}
+	if x.Nvol != 0 {
+		w.field(`"nvol":`)
+		w.int(int64(x.Nvol))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Audio) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Audio) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "mimes", "MIMES":
+			if r.null() {
+				x.Mimes = nil
+			} else if r.array(&x.Mimes) {
+				if x.Mimes == nil {
+					x.Mimes = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Mimes = grow(x.Mimes, i)
+					if v, ok := r.string(); ok {
+						x.Mimes[i] = v
+					}
+				}
+				x.Mimes = truncate(x.Mimes, i)
+			}
+		case "minduration", "MINDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.Minduration = int(v)
+			}
+		case "maxduration", "MAXDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.Maxduration = int(v)
+			}
+		case "protocols", "PROTOCOLS":
+			if r.null() {
+				x.Protocols = nil
+			} else if r.array(&x.Protocols) {
+				if x.Protocols == nil {
+					x.Protocols = poolProtocolSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Protocols = grow(x.Protocols, i)
+					if v, ok := r.int(intSize); ok {
+						x.Protocols[i] = Protocol(v)
+					}
+				}
+				x.Protocols = truncate(x.Protocols, i)
+			}
+		case "startdelay", "STARTDELAY":
+			if v, ok := r.int(intSize); ok {
+				x.StartDelay = StartDelay(v)
+			}
+		case "sequence", "SEQUENCE":
+			if v, ok := r.int(intSize); ok {
+				x.Sequence = int(v)
+			}
+		case "battr", "BATTR":
+			if r.null() {
+				x.Battr = nil
+			} else if r.array(&x.Battr) {
+				if x.Battr == nil {
+					x.Battr = poolCreativeAttributeSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Battr = grow(x.Battr, i)
+					if v, ok := r.int(intSize); ok {
+						x.Battr[i] = CreativeAttribute(v)
+					}
+				}
+				x.Battr = truncate(x.Battr, i)
+			}
+		case "maxextended", "MAXEXTENDED":
+			if v, ok := r.int(intSize); ok {
+				x.MaxExtended = int(v)
+			}
+		case "minbitrate", "MINBITRATE":
+			if v, ok := r.int(intSize); ok {
+				x.MinBitRate = int(v)
+			}
+		case "maxbitrate", "MAXBITRATE":
+			if v, ok := r.int(intSize); ok {
+				x.MaxBitRate = int(v)
+			}
+		case "delivery", "DELIVERY":
+			if r.null() {
+				x.Delivery = nil
+			} else if r.array(&x.Delivery) {
+				if x.Delivery == nil {
+					x.Delivery = poolContentDeliverySlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Delivery = grow(x.Delivery, i)
+					if v, ok := r.int(intSize); ok {
+						x.Delivery[i] = ContentDelivery(v)
+					}
+				}
+				x.Delivery = truncate(x.Delivery, i)
+			}
+		case "companionad", "COMPANIONAD":
+			if r.null() {
+				x.CompanionAd = nil
+			} else if r.array(&x.CompanionAd) {
+				if x.CompanionAd == nil {
+					x.CompanionAd = poolBannerSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.CompanionAd = grow(x.CompanionAd, i)
+					x.CompanionAd[i].readJSON(r)
+				}
+				x.CompanionAd = truncate(x.CompanionAd, i)
+			}
+		case "api", "API":
+			if r.null() {
+				x.API = nil
+			} else if r.array(&x.API) {
+				if x.API == nil {
+					x.API = poolAPIFrameworkSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.API = grow(x.API, i)
+					if v, ok := r.int(intSize); ok {
+						x.API[i] = APIFramework(v)
+					}
+				}
+				x.API = truncate(x.API, i)
+			}
+		case "companiontype", "COMPANIONTYPE":
+			if r.null() {
+				x.CompanionType = nil
+			} else if r.array(&x.CompanionType) {
+				if x.CompanionType == nil {
+					x.CompanionType = poolCompanionTypeSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.CompanionType = grow(x.CompanionType, i)
+					if v, ok := r.int(intSize); ok {
+						x.CompanionType[i] = CompanionType(v)
+					}
+				}
+				x.CompanionType = truncate(x.CompanionType, i)
+			}
+		case "maxseq", "MAXSEQ":
+			if v, ok := r.int(intSize); ok {
+				x.Maxseq = int(v)
+			}
+		case "feed", "FEED":
+			if v, ok := r.int(intSize); ok {
+				x.Feed = FeedType(v)
+			}
+		case "stitched", "STITCHED":
+			if v, ok := r.int(intSize); ok {
+				x.Stitched = int(v)
+			}
+		case "nvol", "NVOL":
+			if v, ok := r.int(intSize); ok {
+				x.Nvol = VolumeNormalization(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolAudioExt.Get().(*AudioExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x AudioExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *AudioExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *AudioExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *AudioExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Banner) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 576)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Banner) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.BidFloor != nil {
+		w.field(`"bidfloor":`)
+		w.float(float64(*x.BidFloor), 64)
+	}
+	if len(x.BAttr) != 0 {
+		w.field(`"battr":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.BAttr {
+			w.elem(i)
+			w.int(int64(x.BAttr[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.Format) != 0 {
+		w.field(`"format":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Format {
+			w.elem(i)
+			x.Format[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Pos != 0 {
+		w.field(`"pos":`)
+		w.int(int64(x.Pos))
+	}
+	if len(x.API) != 0 {
+		w.field(`"api":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.API {
+			w.elem(i)
+			w.int(int64(x.API[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Banner) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Banner) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "bidfloor", "BIDFLOOR":
+			if r.null() {
+				x.BidFloor = nil
+			} else {
+				if x.BidFloor == nil {
+					x.BidFloor = new(float64)
+				}
+				if v, ok := r.float(64); ok {
+					*x.BidFloor = v
+				}
+			}
+		case "battr", "BATTR":
+			if r.null() {
+				x.BAttr = nil
+			} else if r.array(&x.BAttr) {
+				if x.BAttr == nil {
+					x.BAttr = poolCreativeAttributeSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.BAttr = grow(x.BAttr, i)
+					if v, ok := r.int(intSize); ok {
+						x.BAttr[i] = CreativeAttribute(v)
+					}
+				}
+				x.BAttr = truncate(x.BAttr, i)
+			}
+		case "format", "FORMAT":
+			if r.null() {
+				x.Format = nil
+			} else if r.array(&x.Format) {
+				if x.Format == nil {
+					x.Format = poolFormatSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Format = grow(x.Format, i)
+					x.Format[i].readJSON(r)
+				}
+				x.Format = truncate(x.Format, i)
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "pos", "POS":
+			if v, ok := r.int(intSize); ok {
+				x.Pos = AdPosition(v)
+			}
+		case "api", "API":
+			if r.null() {
+				x.API = nil
+			} else if r.array(&x.API) {
+				if x.API == nil {
+					x.API = poolAPIFrameworkSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.API = grow(x.API, i)
+					if v, ok := r.int(intSize); ok {
+						x.API[i] = APIFramework(v)
+					}
+				}
+				x.API = truncate(x.API, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolBannerExt.Get().(*BannerExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x BannerExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *BannerExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *BannerExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *BannerExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Bid) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1728)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Bid) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.string(string(x.ID))
+	w.field(`"impid":`)
+	w.string(string(x.ImpID))
+	w.field(`"price":`)
+	w.float(float64(x.Price), 64)
+	w.field(`"nurl":`)
+	w.string(string(x.NURL))
+	w.field(`"burl":`)
+	w.string(string(x.BURL))
+	w.field(`"lurl":`)
+	w.string(string(x.LURL))
+	w.field(`"adm":`)
+	w.string(string(x.Adm))
+	w.field(`"adid":`)
+	w.string(string(x.Adid))
+	w.field(`"adomain":`)
+	if x.Adomain == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Adomain {
+			w.elem(i)
+			w.string(string(x.Adomain[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"bundle":`)
+	w.string(string(x.Bundle))
+	w.field(`"iurl":`)
+	w.string(string(x.IURL))
+	w.field(`"cid":`)
+	w.string(string(x.Cid))
+	w.field(`"crid":`)
+	w.string(string(x.Crid))
+	w.field(`"tactic":`)
+	w.string(string(x.Tactic))
+	w.field(`"cat":`)
+	if x.Cat == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"attr":`)
+	if x.Attr == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Attr {
+			w.elem(i)
+			w.int(int64(x.Attr[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"api":`)
+	w.int(int64(x.API))
+	w.field(`"protocol":`)
+	w.int(int64(x.Protocol))
+	w.field(`"qagmediarating":`)
+	w.int(int64(x.QagMediaRating))
+	w.field(`"language":`)
+	w.string(string(x.Language))
+	w.field(`"dealid":`)
+	w.string(string(x.DealID))
+	w.field(`"w":`)
+	w.int(int64(x.W))
+	w.field(`"h":`)
+	w.int(int64(x.H))
+	w.field(`"wratio":`)
+	w.int(int64(x.WRatio))
+	w.field(`"hratio":`)
+	w.int(int64(x.HRatio))
+	w.field(`"exp":`)
+	w.int(int64(x.Exp))
+	w.field(`"ext":`)
+	x.Ext.writeJSON(w)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Bid) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Bid) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "impid", "IMPID":
+			if v, ok := r.string(); ok {
+				x.ImpID = v
+			}
+		case "price", "PRICE":
+			if v, ok := r.float(64); ok {
+				x.Price = v
+			}
+		case "nurl", "NURL":
+			if v, ok := r.string(); ok {
+				x.NURL = v
+			}
+		case "burl", "BURL":
+			if v, ok := r.string(); ok {
+				x.BURL = v
+			}
+		case "lurl", "LURL":
+			if v, ok := r.string(); ok {
+				x.LURL = v
+			}
+		case "adm", "ADM":
+			if v, ok := r.string(); ok {
+				x.Adm = v
+			}
+		case "adid", "ADID":
+			if v, ok := r.string(); ok {
+				x.Adid = v
+			}
+		case "adomain", "ADOMAIN":
+			if r.null() {
+				x.Adomain = nil
+			} else if r.array(&x.Adomain) {
+				if x.Adomain == nil {
+					x.Adomain = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Adomain = grow(x.Adomain, i)
+					if v, ok := r.string(); ok {
+						x.Adomain[i] = v
+					}
+				}
+				x.Adomain = truncate(x.Adomain, i)
+			}
+		case "bundle", "BUNDLE":
+			if v, ok := r.string(); ok {
+				x.Bundle = v
+			}
+		case "iurl", "IURL":
+			if v, ok := r.string(); ok {
+				x.IURL = v
+			}
+		case "cid", "CID":
+			if v, ok := r.string(); ok {
+				x.Cid = v
+			}
+		case "crid", "CRID":
+			if v, ok := r.string(); ok {
+				x.Crid = v
+			}
+		case "tactic", "TACTIC":
+			if v, ok := r.string(); ok {
+				x.Tactic = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "attr", "ATTR":
+			if r.null() {
+				x.Attr = nil
+			} else if r.array(&x.Attr) {
+				if x.Attr == nil {
+					x.Attr = poolCreativeAttributeSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Attr = grow(x.Attr, i)
+					if v, ok := r.int(intSize); ok {
+						x.Attr[i] = CreativeAttribute(v)
+					}
+				}
+				x.Attr = truncate(x.Attr, i)
+			}
+		case "api", "API":
+			if v, ok := r.int(intSize); ok {
+				x.API = APIFramework(v)
+			}
+		case "protocol", "PROTOCOL":
+			if v, ok := r.int(intSize); ok {
+				x.Protocol = Protocol(v)
+			}
+		case "qagmediarating", "QAGMEDIARATING":
+			if v, ok := r.int(intSize); ok {
+				x.QagMediaRating = QAGMediaRating(v)
+			}
+		case "language", "LANGUAGE":
+			if v, ok := r.string(); ok {
+				x.Language = v
+			}
+		case "dealid", "DEALID":
+			if v, ok := r.string(); ok {
+				x.DealID = v
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "wratio", "WRATIO":
+			if v, ok := r.int(intSize); ok {
+				x.WRatio = int(v)
+			}
+		case "hratio", "HRATIO":
+			if v, ok := r.int(intSize); ok {
+				x.HRatio = int(v)
+			}
+		case "exp", "EXP":
+			if v, ok := r.int(intSize); ok {
+				x.Exp = int(v)
+			}
+		case "ext", "EXT":
+			x.Ext.readJSON(r)
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x BidExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *BidExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *BidExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *BidExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x BidResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 448)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *BidResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.string(string(x.ID))
+	w.field(`"seatbid":`)
+	if x.SeatBid == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.SeatBid {
+			w.elem(i)
+			x.SeatBid[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"bidid":`)
+	w.string(string(x.BidID))
+	w.field(`"cur":`)
+	w.string(string(x.Cur))
+	w.field(`"customdata":`)
+	w.string(string(x.CustomData))
+	w.field(`"nbr":`)
+	w.int(int64(x.NBR))
+	w.field(`"ext":`)
+	x.Ext.writeJSON(w)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *BidResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *BidResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "seatbid", "SEATBID":
+			if r.null() {
+				x.SeatBid = nil
+			} else if r.array(&x.SeatBid) {
+				if x.SeatBid == nil {
+					x.SeatBid = poolSeatbidSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.SeatBid = grow(x.SeatBid, i)
+					x.SeatBid[i].readJSON(r)
+				}
+				x.SeatBid = truncate(x.SeatBid, i)
+			}
+		case "bidid", "BIDID":
+			if v, ok := r.string(); ok {
+				x.BidID = v
+			}
+		case "cur", "CUR":
+			if v, ok := r.string(); ok {
+				x.Cur = v
+			}
+		case "customdata", "CUSTOMDATA":
+			if v, ok := r.string(); ok {
+				x.CustomData = v
+			}
+		case "nbr", "NBR":
+			if v, ok := r.int(intSize); ok {
+				x.NBR = NoBidReason(v)
+			}
+		case "ext", "EXT":
+			x.Ext.readJSON(r)
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x BidResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *BidResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *BidResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *BidResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Content) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1600)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Content) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Episode != 0 {
+		w.field(`"episode":`)
+		w.int(int64(x.Episode))
+	}
+	if x.Title != "" {
+		w.field(`"title":`)
+		w.string(string(x.Title))
+	}
+	if x.Series != "" {
+		w.field(`"series":`)
+		w.string(string(x.Series))
+	}
+	if x.Season != "" {
+		w.field(`"season":`)
+		w.string(string(x.Season))
+	}
+	if x.Artist != "" {
+		w.field(`"artist":`)
+		w.string(string(x.Artist))
+	}
+	if x.Genre != "" {
+		w.field(`"genre":`)
+		w.string(string(x.Genre))
+	}
+	if x.Album != "" {
+		w.field(`"album":`)
+		w.string(string(x.Album))
+	}
+	if x.ISRC != "" {
+		w.field(`"isrc":`)
+		w.string(string(x.ISRC))
+	}
+	if x.Producer != nil {
+		w.field(`"producer":`)
+		x.Producer.writeJSON(w)
+	}
+	if x.URL != "" {
+		w.field(`"url":`)
+		w.string(string(x.URL))
+	}
+	if len(x.Cat) != 0 {
+		w.field(`"cat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Prodq != 0 {
+		w.field(`"prodq":`)
+		w.int(int64(x.Prodq))
+	}
+	if x.Context != 0 {
+		w.field(`"context":`)
+		w.int(int64(x.Context))
+	}
+	if x.ContentRating != "" {
+		w.field(`"contentrating":`)
+		w.string(string(x.ContentRating))
+	}
+	if x.UserRating != "" {
+		w.field(`"userrating":`)
+		w.string(string(x.UserRating))
+	}
+	if x.QagMediaRating != 0 {
+		w.field(`"qagmediarating":`)
+		w.int(int64(x.QagMediaRating))
+	}
+	if len(x.Keywords) != 0 {
+		w.field(`"keywords":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Keywords {
+			w.elem(i)
+			w.string(string(x.Keywords[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.LiveStream != 0 {
+		w.field(`"livestream":`)
+		w.int(int64(x.LiveStream))
+	}
+	if x.SourceRelationShip != 0 {
+		w.field(`"sourcerelationship":`)
+		w.int(int64(x.SourceRelationShip))
+	}
+	if x.Len != 0 {
+		w.field(`"len":`)
+		w.int(int64(x.Len))
+	}
+	if x.Language != "" {
+		w.field(`"language":`)
+		w.string(string(x.Language))
+	}
+	if x.Embeddable != 0 {
+		w.field(`"embeddable":`)
+		w.int(int64(x.Embeddable))
+	}
+	if len(x.Data) != 0 {
+		w.field(`"data":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Data {
+			w.elem(i)
+			x.Data[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Content) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Content) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "episode", "EPISODE":
+			if v, ok := r.int(intSize); ok {
+				x.Episode = int(v)
+			}
+		case "title", "TITLE":
+			if v, ok := r.string(); ok {
+				x.Title = v
+			}
+		case "series", "SERIES":
+			if v, ok := r.string(); ok {
+				x.Series = v
+			}
+		case "season", "SEASON":
+			if v, ok := r.string(); ok {
+				x.Season = v
+			}
+		case "artist", "ARTIST":
+			if v, ok := r.string(); ok {
+				x.Artist = v
+			}
+		case "genre", "GENRE":
+			if v, ok := r.string(); ok {
+				x.Genre = v
+			}
+		case "album", "ALBUM":
+			if v, ok := r.string(); ok {
+				x.Album = v
+			}
+		case "isrc", "ISRC":
+			if v, ok := r.string(); ok {
+				x.ISRC = v
+			}
+		case "producer", "PRODUCER":
+			if r.null() {
+				x.Producer = nil
+			} else {
+				if x.Producer == nil {
+					x.Producer = poolProducer.Get().(*Producer)
+				}
+				x.Producer.readJSON(r)
+			}
+		case "url", "URL":
+			if v, ok := r.string(); ok {
+				x.URL = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "prodq", "PRODQ":
+			if v, ok := r.int(intSize); ok {
+				x.Prodq = ProductionQuality(v)
+			}
+		case "context", "CONTEXT":
+			if v, ok := r.int(intSize); ok {
+				x.Context = ContentContext(v)
+			}
+		case "contentrating", "CONTENTRATING":
+			if v, ok := r.string(); ok {
+				x.ContentRating = v
+			}
+		case "userrating", "USERRATING":
+			if v, ok := r.string(); ok {
+				x.UserRating = v
+			}
+		case "qagmediarating", "QAGMEDIARATING":
+			if v, ok := r.int(intSize); ok {
+				x.QagMediaRating = QAGMediaRating(v)
+			}
+		case "keywords", "KEYWORDS":
+			if r.null() {
+				x.Keywords = nil
+			} else if r.array(&x.Keywords) {
+				if x.Keywords == nil {
+					x.Keywords = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Keywords = grow(x.Keywords, i)
+					if v, ok := r.string(); ok {
+						x.Keywords[i] = v
+					}
+				}
+				x.Keywords = truncate(x.Keywords, i)
+			}
+		case "livestream", "LIVESTREAM":
+			if v, ok := r.int(intSize); ok {
+				x.LiveStream = int(v)
+			}
+		case "sourcerelationship", "SOURCERELATIONSHIP":
+			if v, ok := r.int(intSize); ok {
+				x.SourceRelationShip = int(v)
+			}
+		case "len", "LEN":
+			if v, ok := r.int(intSize); ok {
+				x.Len = int(v)
+			}
+		case "language", "LANGUAGE":
+			if v, ok := r.string(); ok {
+				x.Language = v
+			}
+		case "embeddable", "EMBEDDABLE":
+			if v, ok := r.int(intSize); ok {
+				x.Embeddable = int(v)
+			}
+		case "data", "DATA":
+			if r.null() {
+				x.Data = nil
+			} else if r.array(&x.Data) {
+				if x.Data == nil {
+					x.Data = poolDataSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Data = grow(x.Data, i)
+					x.Data[i].readJSON(r)
+				}
+				x.Data = truncate(x.Data, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolContentExt.Get().(*ContentExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x ContentExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *ContentExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *ContentExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *ContentExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Data) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Data) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Name != "" {
+		w.field(`"name":`)
+		w.string(string(x.Name))
+	}
+	if len(x.Segment) != 0 {
+		w.field(`"segment":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Segment {
+			w.elem(i)
+			x.Segment[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Data) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Data) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "segment", "SEGMENT":
+			if r.null() {
+				x.Segment = nil
+			} else if r.array(&x.Segment) {
+				if x.Segment == nil {
+					x.Segment = poolSegmentSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Segment = grow(x.Segment, i)
+					x.Segment[i].readJSON(r)
+				}
+				x.Segment = truncate(x.Segment, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolDataExt.Get().(*DataExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x DataExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *DataExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *DataExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *DataExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Deal) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 448)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Deal) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.string(string(x.ID))
+	if x.BidFloor != 0 {
+		w.field(`"bidfloor":`)
+		w.float(float64(x.BidFloor), 64)
+	}
+	if x.BidFloorCur != 0 {
+		w.field(`"bidfloorcur":`)
+		w.float(float64(x.BidFloorCur), 64)
+	}
+	if x.At != 0 {
+		w.field(`"at":`)
+		w.int(int64(x.At))
+	}
+	if len(x.WSeat) != 0 {
+		w.field(`"wseat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.WSeat {
+			w.elem(i)
+			w.string(string(x.WSeat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.WAdomain) != 0 {
+		w.field(`"wadomain":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.WAdomain {
+			w.elem(i)
+			w.string(string(x.WAdomain[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Deal) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Deal) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "bidfloor", "BIDFLOOR":
+			if v, ok := r.float(64); ok {
+				x.BidFloor = v
+			}
+		case "bidfloorcur", "BIDFLOORCUR":
+			if v, ok := r.float(64); ok {
+				x.BidFloorCur = v
+			}
+		case "at", "AT":
+			if v, ok := r.int(intSize); ok {
+				x.At = int(v)
+			}
+		case "wseat", "WSEAT":
+			if r.null() {
+				x.WSeat = nil
+			} else if r.array(&x.WSeat) {
+				if x.WSeat == nil {
+					x.WSeat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.WSeat = grow(x.WSeat, i)
+					if v, ok := r.string(); ok {
+						x.WSeat[i] = v
+					}
+				}
+				x.WSeat = truncate(x.WSeat, i)
+			}
+		case "wadomain", "WADOMAIN":
+			if r.null() {
+				x.WAdomain = nil
+			} else if r.array(&x.WAdomain) {
+				if x.WAdomain == nil {
+					x.WAdomain = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.WAdomain = grow(x.WAdomain, i)
+					if v, ok := r.string(); ok {
+						x.WAdomain[i] = v
+					}
+				}
+				x.WAdomain = truncate(x.WAdomain, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolDealExt.Get().(*DealExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x DealExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *DealExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *DealExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *DealExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Device) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1536)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Device) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"ua":`)
+	w.string(string(x.Ua))
+	if x.Geo != nil {
+		w.field(`"geo":`)
+		x.Geo.writeJSON(w)
+	}
+	w.field(`"dnt":`)
+	w.int(int64(x.Dnt))
+	w.field(`"lmt":`)
+	w.int(int64(x.Lmt))
+	w.field(`"ip":`)
+	w.string(string(x.IP))
+	if x.IPv6 != "" {
+		w.field(`"ipv6":`)
+		w.string(string(x.IPv6))
+	}
+	if x.DeviceType != 0 {
+		w.field(`"device_type":`)
+		w.int(int64(x.DeviceType))
+	}
+	if x.Make != "" {
+		w.field(`"make":`)
+		w.string(string(x.Make))
+	}
+	if x.Model != "" {
+		w.field(`"model":`)
+		w.string(string(x.Model))
+	}
+	if x.OS != "" {
+		w.field(`"os":`)
+		w.string(string(x.OS))
+	}
+	if x.OSV != "" {
+		w.field(`"osv":`)
+		w.string(string(x.OSV))
+	}
+	if x.HWV != "" {
+		w.field(`"hwv":`)
+		w.string(string(x.HWV))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.PPI != 0 {
+		w.field(`"ppi":`)
+		w.int(int64(x.PPI))
+	}
+	if x.PXRatio != 0 {
+		w.field(`"px_ratio":`)
+		w.float(float64(x.PXRatio), 64)
+	}
+	if x.JS != 0 {
+		w.field(`"js":`)
+		w.int(int64(x.JS))
+	}
+	if x.GeoFetch != 0 {
+		w.field(`"geo_fetch":`)
+		w.int(int64(x.GeoFetch))
+	}
+	if x.FlashVer != "" {
+		w.field(`"flash_ver":`)
+		w.string(string(x.FlashVer))
+	}
+	if x.Language != "" {
+		w.field(`"language":`)
+		w.string(string(x.Language))
+	}
+	if x.Carrier != "" {
+		w.field(`"carrier":`)
+		w.string(string(x.Carrier))
+	}
+	if x.ConnectionType != 0 {
+		w.field(`"connectiontype":`)
+		w.int(int64(x.ConnectionType))
+	}
+	w.field(`"ifa":`)
+	w.string(string(x.Ifa))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Device) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Device) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "ua", "UA":
+			if v, ok := r.string(); ok {
+				x.Ua = v
+			}
+		case "geo", "GEO":
+			if r.null() {
+				x.Geo = nil
+			} else {
+				if x.Geo == nil {
+					x.Geo = poolGeo.Get().(*Geo)
+				}
+				x.Geo.readJSON(r)
+			}
+		case "dnt", "DNT":
+			if v, ok := r.int(intSize); ok {
+				x.Dnt = int(v)
+			}
+		case "lmt", "LMT":
+			if v, ok := r.int(intSize); ok {
+				x.Lmt = int(v)
+			}
+		case "ip", "IP":
+			if v, ok := r.string(); ok {
+				x.IP = v
+			}
+		case "ipv6", "IPV6":
+			if v, ok := r.string(); ok {
+				x.IPv6 = v
+			}
+		case "device_type", "DEVICE_TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.DeviceType = DeviceType(v)
+			}
+		case "make", "MAKE":
+			if v, ok := r.string(); ok {
+				x.Make = v
+			}
+		case "model", "MODEL":
+			if v, ok := r.string(); ok {
+				x.Model = v
+			}
+		case "os", "OS":
+			if v, ok := r.string(); ok {
+				x.OS = v
+			}
+		case "osv", "OSV":
+			if v, ok := r.string(); ok {
+				x.OSV = v
+			}
+		case "hwv", "HWV":
+			if v, ok := r.string(); ok {
+				x.HWV = v
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "ppi", "PPI":
+			if v, ok := r.int(intSize); ok {
+				x.PPI = int(v)
+			}
+		case "px_ratio", "PX_RATIO":
+			if v, ok := r.float(64); ok {
+				x.PXRatio = v
+			}
+		case "js", "JS":
+			if v, ok := r.int(intSize); ok {
+				x.JS = int(v)
+			}
+		case "geo_fetch", "GEO_FETCH":
+			if v, ok := r.int(intSize); ok {
+				x.GeoFetch = int(v)
+			}
+		case "flash_ver", "FLASH_VER":
+			if v, ok := r.string(); ok {
+				x.FlashVer = v
+			}
+		case "language", "LANGUAGE":
+			if v, ok := r.string(); ok {
+				x.Language = v
+			}
+		case "carrier", "CARRIER":
+			if v, ok := r.string(); ok {
+				x.Carrier = v
+			}
+		case "connectiontype", "CONNECTIONTYPE":
+			if v, ok := r.int(intSize); ok {
+				x.ConnectionType = ConnectionType(v)
+			}
+		case "ifa", "IFA":
+			if v, ok := r.string(); ok {
+				x.Ifa = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolDeviceExt.Get().(*DeviceExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x DeviceExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *DeviceExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *DeviceExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *DeviceExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Format) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 384)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Format) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	if x.WRatio != 0 {
+		w.field(`"wratio":`)
+		w.int(int64(x.WRatio))
+	}
+	if x.HRatio != 0 {
+		w.field(`"hratio":`)
+		w.int(int64(x.HRatio))
+	}
+	if x.WMin != 0 {
+		w.field(`"wmin":`)
+		w.int(int64(x.WMin))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Format) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Format) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "wratio", "WRATIO":
+			if v, ok := r.int(intSize); ok {
+				x.WRatio = int(v)
+			}
+		case "hratio", "HRATIO":
+			if v, ok := r.int(intSize); ok {
+				x.HRatio = int(v)
+			}
+		case "wmin", "WMIN":
+			if v, ok := r.int(intSize); ok {
+				x.WMin = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolFormatExt.Get().(*FormatExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x FormatExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *FormatExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *FormatExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *FormatExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Geo) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 448)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Geo) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Lat != 0 {
+		w.field(`"lat":`)
+		w.float(float64(x.Lat), 64)
+	}
+	if x.Lon != 0 {
+		w.field(`"lon":`)
+		w.float(float64(x.Lon), 64)
+	}
+	if x.Type != 0 {
+		w.field(`"type":`)
+		w.int(int64(x.Type))
+	}
+	if x.IPService != 0 {
+		w.field(`"ipservice":`)
+		w.int(int64(x.IPService))
+	}
+	if x.Country != "" {
+		w.field(`"country":`)
+		w.string(string(x.Country))
+	}
+	if x.City != "" {
+		w.field(`"city":`)
+		w.string(string(x.City))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Geo) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Geo) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "lat", "LAT":
+			if v, ok := r.float(64); ok {
+				x.Lat = v
+			}
+		case "lon", "LON":
+			if v, ok := r.float(64); ok {
+				x.Lon = v
+			}
+		case "type", "TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.Type = LocationType(v)
+			}
+		case "ipservice", "IPSERVICE":
+			if v, ok := r.int(intSize); ok {
+				x.IPService = IPLocationService(v)
+			}
+		case "country", "COUNTRY":
+			if v, ok := r.string(); ok {
+				x.Country = v
+			}
+		case "city", "CITY":
+			if v, ok := r.string(); ok {
+				x.City = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolGeoExt.Get().(*GeoExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x GeoExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *GeoExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *GeoExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *GeoExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Imp) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 960)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Imp) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if len(x.Metric) != 0 {
+		w.field(`"metric":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Metric {
+			w.elem(i)
+			x.Metric[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Banner != nil {
+		w.field(`"banner":`)
+		x.Banner.writeJSON(w)
+	}
+	if x.Video != nil {
+		w.field(`"video":`)
+		x.Video.writeJSON(w)
+	}
+	if x.Audio != nil {
+		w.field(`"audio":`)
+		x.Audio.writeJSON(w)
+	}
+	if x.Native != nil {
+		w.field(`"native":`)
+		x.Native.writeJSON(w)
+	}
+	if x.PMP != nil {
+		w.field(`"pmp":`)
+		x.PMP.writeJSON(w)
+	}
+	if x.DisplayManager != "" {
+		w.field(`"displaymanager":`)
+		w.string(string(x.DisplayManager))
+	}
+	if x.DisplayManagerServer != "" {
+		w.field(`"displaymanagerserver":`)
+		w.string(string(x.DisplayManagerServer))
+	}
+	w.field(`"instl":`)
+	w.int(int64(x.Instl))
+	if x.TagID != "" {
+		w.field(`"tagid":`)
+		w.string(string(x.TagID))
+	}
+	w.field(`"bidfloor":`)
+	w.float(float64(x.BidFloor), 64)
+	if x.BidFloorCur != "" {
+		w.field(`"bidfloorcur":`)
+		w.string(string(x.BidFloorCur))
+	}
+	w.field(`"secure":`)
+	w.int(int64(x.Secure))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Imp) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Imp) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "metric", "METRIC":
+			if r.null() {
+				x.Metric = nil
+			} else if r.array(&x.Metric) {
+				if x.Metric == nil {
+					x.Metric = poolMetricSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Metric = grow(x.Metric, i)
+					x.Metric[i].readJSON(r)
+				}
+				x.Metric = truncate(x.Metric, i)
+			}
+		case "banner", "BANNER":
+			if r.null() {
+				x.Banner = nil
+			} else {
+				if x.Banner == nil {
+					x.Banner = poolBanner.Get().(*Banner)
+				}
+				x.Banner.readJSON(r)
+			}
+		case "video", "VIDEO":
+			if r.null() {
+				x.Video = nil
+			} else {
+				if x.Video == nil {
+					x.Video = poolVideo.Get().(*Video)
+				}
+				x.Video.readJSON(r)
+			}
+		case "audio", "AUDIO":
+			if r.null() {
+				x.Audio = nil
+			} else {
+				if x.Audio == nil {
+					x.Audio = poolAudio.Get().(*Audio)
+				}
+				x.Audio.readJSON(r)
+			}
+		case "native", "NATIVE":
+			if r.null() {
+				x.Native = nil
+			} else {
+				if x.Native == nil {
+					x.Native = poolNative.Get().(*Native)
+				}
+				x.Native.readJSON(r)
+			}
+		case "pmp", "PMP":
+			if r.null() {
+				x.PMP = nil
+			} else {
+				if x.PMP == nil {
+					x.PMP = poolPMP.Get().(*PMP)
+				}
+				x.PMP.readJSON(r)
+			}
+		case "displaymanager", "DISPLAYMANAGER":
+			if v, ok := r.string(); ok {
+				x.DisplayManager = v
+			}
+		case "displaymanagerserver", "DISPLAYMANAGERSERVER":
+			if v, ok := r.string(); ok {
+				x.DisplayManagerServer = v
+			}
+		case "instl", "INSTL":
+			if v, ok := r.int(intSize); ok {
+				x.Instl = int(v)
+			}
+		case "tagid", "TAGID":
+			if v, ok := r.string(); ok {
+				x.TagID = v
+			}
+		case "bidfloor", "BIDFLOOR":
+			if v, ok := r.float(64); ok {
+				x.BidFloor = v
+			}
+		case "bidfloorcur", "BIDFLOORCUR":
+			if v, ok := r.string(); ok {
+				x.BidFloorCur = v
+			}
+		case "secure", "SECURE":
+			if v, ok := r.int(intSize); ok {
+				x.Secure = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolImpExt.Get().(*ImpExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x ImpExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *ImpExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *ImpExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *ImpExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Metric) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Metric) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Type != "" {
+		w.field(`"type":`)
+		w.string(string(x.Type))
+	}
+	if x.Value != "" {
+		w.field(`"value":`)
+		w.string(string(x.Value))
+	}
+	if x.Vendor != "" {
+		w.field(`"vendor":`)
+		w.string(string(x.Vendor))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Metric) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Metric) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "type", "TYPE":
+			if v, ok := r.string(); ok {
+				x.Type = v
+			}
+		case "value", "VALUE":
+			if v, ok := r.string(); ok {
+				x.Value = v
+			}
+		case "vendor", "VENDOR":
+			if v, ok := r.string(); ok {
+				x.Vendor = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolMetricExt.Get().(*MetricExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x MetricExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *MetricExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *MetricExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *MetricExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Native) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Native) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"request":`)
+	w.string(string(x.Request))
+	if x.Ver != "" {
+		w.field(`"ver":`)
+		w.string(string(x.Ver))
+	}
+	if len(x.API) != 0 {
+		w.field(`"api":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.API {
+			w.elem(i)
+			w.int(int64(x.API[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.Battr) != 0 {
+		w.field(`"battr":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Battr {
+			w.elem(i)
+			w.int(int64(x.Battr[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Native) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Native) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "request", "REQUEST":
+			if v, ok := r.string(); ok {
+				x.Request = v
+			}
+		case "ver", "VER":
+			if v, ok := r.string(); ok {
+				x.Ver = v
+			}
+		case "api", "API":
+			if r.null() {
+				x.API = nil
+			} else if r.array(&x.API) {
+				if x.API == nil {
+					x.API = poolAPIFrameworkSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.API = grow(x.API, i)
+					if v, ok := r.int(intSize); ok {
+						x.API[i] = APIFramework(v)
+					}
+				}
+				x.API = truncate(x.API, i)
+			}
+		case "battr", "BATTR":
+			if r.null() {
+				x.Battr = nil
+			} else if r.array(&x.Battr) {
+				if x.Battr == nil {
+					x.Battr = poolCreativeAttributeSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Battr = grow(x.Battr, i)
+					if v, ok := r.int(intSize); ok {
+						x.Battr[i] = CreativeAttribute(v)
+					}
+				}
+				x.Battr = truncate(x.Battr, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeExt.Get().(*NativeExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeAsset) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 448)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeAsset) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.int(int64(x.ID))
+	if x.Required != 0 {
+		w.field(`"required":`)
+		w.int(int64(x.Required))
+	}
+	if x.Title != nil {
+		w.field(`"title":`)
+		x.Title.writeJSON(w)
+	}
+	if x.Img != nil {
+		w.field(`"img":`)
+		x.Img.writeJSON(w)
+	}
+	if x.Video != nil {
+		w.field(`"video":`)
+		x.Video.writeJSON(w)
+	}
+	if x.Data != nil {
+		w.field(`"data":`)
+		x.Data.writeJSON(w)
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeAsset) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeAsset) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.int(intSize); ok {
+				x.ID = int(v)
+			}
+		case "required", "REQUIRED":
+			if v, ok := r.int(intSize); ok {
+				x.Required = int(v)
+			}
+		case "title", "TITLE":
+			if r.null() {
+				x.Title = nil
+			} else {
+				if x.Title == nil {
+					x.Title = poolNativeTitle.Get().(*NativeTitle)
+				}
+				x.Title.readJSON(r)
+			}
+		case "img", "IMG":
+			if r.null() {
+				x.Img = nil
+			} else {
+				if x.Img == nil {
+					x.Img = poolNativeImage.Get().(*NativeImage)
+				}
+				x.Img.readJSON(r)
+			}
+		case "video", "VIDEO":
+			if r.null() {
+				x.Video = nil
+			} else {
+				if x.Video == nil {
+					x.Video = poolNativeVideo.Get().(*NativeVideo)
+				}
+				x.Video.readJSON(r)
+			}
+		case "data", "DATA":
+			if r.null() {
+				x.Data = nil
+			} else {
+				if x.Data == nil {
+					x.Data = poolNativeData.Get().(*NativeData)
+				}
+				x.Data.readJSON(r)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeAssetExt.Get().(*NativeAssetExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeAssetExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeAssetExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeAssetExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeAssetExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeData) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 192)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeData) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"type":`)
+	w.int(int64(x.Type))
+	if x.Len != 0 {
+		w.field(`"len":`)
+		w.int(int64(x.Len))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeData) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeData) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "type", "TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.Type = DataAssetType(v)
+			}
+		case "len", "LEN":
+			if v, ok := r.int(intSize); ok {
+				x.Len = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeDataExt.Get().(*NativeDataExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeDataExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeDataExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeDataExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeDataExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeDataResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeDataResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Type != 0 {
+		w.field(`"type":`)
+		w.int(int64(x.Type))
+	}
+	if x.Len != 0 {
+		w.field(`"len":`)
+		w.int(int64(x.Len))
+	}
+	w.field(`"value":`)
+	w.string(string(x.Value))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeDataResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeDataResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "type", "TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.Type = DataAssetType(v)
+			}
+		case "len", "LEN":
+			if v, ok := r.int(intSize); ok {
+				x.Len = int(v)
+			}
+		case "value", "VALUE":
+			if v, ok := r.string(); ok {
+				x.Value = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeDataResponseExt.Get().(*NativeDataResponseExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeDataResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeDataResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeDataResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeDataResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeEventTracker) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 192)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeEventTracker) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"event":`)
+	w.int(int64(x.Event))
+	w.field(`"methods":`)
+	if x.Methods == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Methods {
+			w.elem(i)
+			w.int(int64(x.Methods[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeEventTracker) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeEventTracker) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "event", "EVENT":
+			if v, ok := r.int(intSize); ok {
+				x.Event = EventType(v)
+			}
+		case "methods", "METHODS":
+			if r.null() {
+				x.Methods = nil
+			} else if r.array(&x.Methods) {
+				if x.Methods == nil {
+					x.Methods = poolEventTrackingMethodSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Methods = grow(x.Methods, i)
+					if v, ok := r.int(intSize); ok {
+						x.Methods[i] = EventTrackingMethod(v)
+					}
+				}
+				x.Methods = truncate(x.Methods, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeEventTrackerExt.Get().(*NativeEventTrackerExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeEventTrackerExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeEventTrackerExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeEventTrackerExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeEventTrackerExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeEventTrackerResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeEventTrackerResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"event":`)
+	w.int(int64(x.Event))
+	w.field(`"method":`)
+	w.int(int64(x.Method))
+	if x.URL != "" {
+		w.field(`"url":`)
+		w.string(string(x.URL))
+	}
+	if len(x.CustomData) != 0 {
+		w.field(`"customdata":`)
+		w.raw(x.CustomData)
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeEventTrackerResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeEventTrackerResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "event", "EVENT":
+			if v, ok := r.int(intSize); ok {
+				x.Event = EventType(v)
+			}
+		case "method", "METHOD":
+			if v, ok := r.int(intSize); ok {
+				x.Method = EventTrackingMethod(v)
+			}
+		case "url", "URL":
+			if v, ok := r.string(); ok {
+				x.URL = v
+			}
+		case "customdata", "CUSTOMDATA":
+			r.rawMessage(&x.CustomData)
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeEventTrackerResponseExt.Get().(*NativeEventTrackerResponseExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeEventTrackerResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeEventTrackerResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeEventTrackerResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeEventTrackerResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeImage) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 448)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeImage) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Type != 0 {
+		w.field(`"type":`)
+		w.int(int64(x.Type))
+	}
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.WMin != 0 {
+		w.field(`"wmin":`)
+		w.int(int64(x.WMin))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	if x.HMin != 0 {
+		w.field(`"hmin":`)
+		w.int(int64(x.HMin))
+	}
+	if len(x.Mimes) != 0 {
+		w.field(`"mimes":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Mimes {
+			w.elem(i)
+			w.string(string(x.Mimes[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeImage) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeImage) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "type", "TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.Type = ImageAssetType(v)
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "wmin", "WMIN":
+			if v, ok := r.int(intSize); ok {
+				x.WMin = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "hmin", "HMIN":
+			if v, ok := r.int(intSize); ok {
+				x.HMin = int(v)
+			}
+		case "mimes", "MIMES":
+			if r.null() {
+				x.Mimes = nil
+			} else if r.array(&x.Mimes) {
+				if x.Mimes == nil {
+					x.Mimes = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Mimes = grow(x.Mimes, i)
+					if v, ok := r.string(); ok {
+						x.Mimes[i] = v
+					}
+				}
+				x.Mimes = truncate(x.Mimes, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeImageExt.Get().(*NativeImageExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeImageExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeImageExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeImageExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeImageExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeImageResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeImageResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Type != 0 {
+		w.field(`"type":`)
+		w.int(int64(x.Type))
+	}
+	w.field(`"url":`)
+	w.string(string(x.URL))
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeImageResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeImageResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "type", "TYPE":
+			if v, ok := r.int(intSize); ok {
+				x.Type = ImageAssetType(v)
+			}
+		case "url", "URL":
+			if v, ok := r.string(); ok {
+				x.URL = v
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeImageResponseExt.Get().(*NativeImageResponseExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeImageResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeImageResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeImageResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeImageResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeLink) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeLink) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"url":`)
+	w.string(string(x.URL))
+	if len(x.ClickTrackers) != 0 {
+		w.field(`"clicktrackers":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.ClickTrackers {
+			w.elem(i)
+			w.string(string(x.ClickTrackers[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Fallback != "" {
+		w.field(`"fallback":`)
+		w.string(string(x.Fallback))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeLink) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeLink) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "url", "URL":
+			if v, ok := r.string(); ok {
+				x.URL = v
+			}
+		case "clicktrackers", "CLICKTRACKERS":
+			if r.null() {
+				x.ClickTrackers = nil
+			} else if r.array(&x.ClickTrackers) {
+				if x.ClickTrackers == nil {
+					x.ClickTrackers = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.ClickTrackers = grow(x.ClickTrackers, i)
+					if v, ok := r.string(); ok {
+						x.ClickTrackers[i] = v
+					}
+				}
+				x.ClickTrackers = truncate(x.ClickTrackers, i)
+			}
+		case "fallback", "FALLBACK":
+			if v, ok := r.string(); ok {
+				x.Fallback = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeLinkExt.Get().(*NativeLinkExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeLinkExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeLinkExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeLinkExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeLinkExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeRequest) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 896)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeRequest) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Ver != "" {
+		w.field(`"ver":`)
+		w.string(string(x.Ver))
+	}
+	if x.Context != 0 {
+		w.field(`"context":`)
+		w.int(int64(x.Context))
+	}
+	if x.ContextSubType != 0 {
+		w.field(`"contextsubtype":`)
+		w.int(int64(x.ContextSubType))
+	}
+	if x.PlcmtType != 0 {
+		w.field(`"plcmttype":`)
+		w.int(int64(x.PlcmtType))
+	}
+	if x.PlcmtCnt != 0 {
+		w.field(`"plcmtcnt":`)
+		w.int(int64(x.PlcmtCnt))
+	}
+	if x.Seq != 0 {
+		w.field(`"seq":`)
+		w.int(int64(x.Seq))
+	}
+	w.field(`"assets":`)
+	if x.Assets == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Assets {
+			w.elem(i)
+			x.Assets[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.AURLSupport != 0 {
+		w.field(`"aurlsupport":`)
+		w.int(int64(x.AURLSupport))
+	}
+	if x.DURLSupport != 0 {
+		w.field(`"durlsupport":`)
+		w.int(int64(x.DURLSupport))
+	}
+	if len(x.EventTrackers) != 0 {
+		w.field(`"eventtrackers":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.EventTrackers {
+			w.elem(i)
+			x.EventTrackers[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Privacy != 0 {
+		w.field(`"privacy":`)
+		w.int(int64(x.Privacy))
+	}
+	if x.AdUnit != 0 {
+		w.field(`"adunit":`)
+		w.int(int64(x.AdUnit))
+	}
+	if x.Layout != 0 {
+		w.field(`"layout":`)
+		w.int(int64(x.Layout))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeRequest) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeRequest) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "ver", "VER":
+			if v, ok := r.string(); ok {
+				x.Ver = v
+			}
+		case "context", "CONTEXT":
+			if v, ok := r.int(intSize); ok {
+				x.Context = NativeContextType(v)
+			}
+		case "contextsubtype", "CONTEXTSUBTYPE":
+			if v, ok := r.int(intSize); ok {
+				x.ContextSubType = NativeContextSubType(v)
+			}
+		case "plcmttype", "PLCMTTYPE":
+			if v, ok := r.int(intSize); ok {
+				x.PlcmtType = NativePlacementType(v)
+			}
+		case "plcmtcnt", "PLCMTCNT":
+			if v, ok := r.int(intSize); ok {
+				x.PlcmtCnt = int(v)
+			}
+		case "seq", "SEQ":
+			if v, ok := r.int(intSize); ok {
+				x.Seq = int(v)
+			}
+		case "assets", "ASSETS":
+			if r.null() {
+				x.Assets = nil
+			} else if r.array(&x.Assets) {
+				if x.Assets == nil {
+					x.Assets = poolNativeAssetSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Assets = grow(x.Assets, i)
+					x.Assets[i].readJSON(r)
+				}
+				x.Assets = truncate(x.Assets, i)
+			}
+		case "aurlsupport", "AURLSUPPORT":
+			if v, ok := r.int(intSize); ok {
+				x.AURLSupport = int(v)
+			}
+		case "durlsupport", "DURLSUPPORT":
+			if v, ok := r.int(intSize); ok {
+				x.DURLSupport = int(v)
+			}
+		case "eventtrackers", "EVENTTRACKERS":
+			if r.null() {
+				x.EventTrackers = nil
+			} else if r.array(&x.EventTrackers) {
+				if x.EventTrackers == nil {
+					x.EventTrackers = poolNativeEventTrackerSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.EventTrackers = grow(x.EventTrackers, i)
+					x.EventTrackers[i].readJSON(r)
+				}
+				x.EventTrackers = truncate(x.EventTrackers, i)
+			}
+		case "privacy", "PRIVACY":
+			if v, ok := r.int(intSize); ok {
+				x.Privacy = int(v)
+			}
+		case "adunit", "ADUNIT":
+			if v, ok := r.int(intSize); ok {
+				x.AdUnit = int(v)
+			}
+		case "layout", "LAYOUT":
+			if v, ok := r.int(intSize); ok {
+				x.Layout = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeRequestExt.Get().(*NativeRequestExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeRequestExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeRequestExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeRequestExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeRequestExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 640)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Ver != "" {
+		w.field(`"ver":`)
+		w.string(string(x.Ver))
+	}
+	if len(x.Assets) != 0 {
+		w.field(`"assets":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Assets {
+			w.elem(i)
+			x.Assets[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.AssetsURL != "" {
+		w.field(`"assetsurl":`)
+		w.string(string(x.AssetsURL))
+	}
+	if x.DCOURL != "" {
+		w.field(`"dcourl":`)
+		w.string(string(x.DCOURL))
+	}
+	w.field(`"link":`)
+	x.Link.writeJSON(w)
+	if len(x.ImpTrackers) != 0 {
+		w.field(`"imptrackers":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.ImpTrackers {
+			w.elem(i)
+			w.string(string(x.ImpTrackers[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.JSTracker != "" {
+		w.field(`"jstracker":`)
+		w.string(string(x.JSTracker))
+	}
+	if len(x.EventTrackers) != 0 {
+		w.field(`"eventtrackers":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.EventTrackers {
+			w.elem(i)
+			x.EventTrackers[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Privacy != "" {
+		w.field(`"privacy":`)
+		w.string(string(x.Privacy))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "ver", "VER":
+			if v, ok := r.string(); ok {
+				x.Ver = v
+			}
+		case "assets", "ASSETS":
+			if r.null() {
+				x.Assets = nil
+			} else if r.array(&x.Assets) {
+				if x.Assets == nil {
+					x.Assets = poolNativeResponseAssetSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Assets = grow(x.Assets, i)
+					x.Assets[i].readJSON(r)
+				}
+				x.Assets = truncate(x.Assets, i)
+			}
+		case "assetsurl", "ASSETSURL":
+			if v, ok := r.string(); ok {
+				x.AssetsURL = v
+			}
+		case "dcourl", "DCOURL":
+			if v, ok := r.string(); ok {
+				x.DCOURL = v
+			}
+		case "link", "LINK":
+			x.Link.readJSON(r)
+		case "imptrackers", "IMPTRACKERS":
+			if r.null() {
+				x.ImpTrackers = nil
+			} else if r.array(&x.ImpTrackers) {
+				if x.ImpTrackers == nil {
+					x.ImpTrackers = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.ImpTrackers = grow(x.ImpTrackers, i)
+					if v, ok := r.string(); ok {
+						x.ImpTrackers[i] = v
+					}
+				}
+				x.ImpTrackers = truncate(x.ImpTrackers, i)
+			}
+		case "jstracker", "JSTRACKER":
+			if v, ok := r.string(); ok {
+				x.JSTracker = v
+			}
+		case "eventtrackers", "EVENTTRACKERS":
+			if r.null() {
+				x.EventTrackers = nil
+			} else if r.array(&x.EventTrackers) {
+				if x.EventTrackers == nil {
+					x.EventTrackers = poolNativeEventTrackerResponseSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.EventTrackers = grow(x.EventTrackers, i)
+					x.EventTrackers[i].readJSON(r)
+				}
+				x.EventTrackers = truncate(x.EventTrackers, i)
+			}
+		case "privacy", "PRIVACY":
+			if v, ok := r.string(); ok {
+				x.Privacy = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeResponseExt.Get().(*NativeResponseExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeResponseAsset) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 512)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeResponseAsset) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.int(int64(x.ID))
+	if x.Required != 0 {
+		w.field(`"required":`)
+		w.int(int64(x.Required))
+	}
+	if x.Title != nil {
+		w.field(`"title":`)
+		x.Title.writeJSON(w)
+	}
+	if x.Img != nil {
+		w.field(`"img":`)
+		x.Img.writeJSON(w)
+	}
+	if x.Video != nil {
+		w.field(`"video":`)
+		x.Video.writeJSON(w)
+	}
+	if x.Data != nil {
+		w.field(`"data":`)
+		x.Data.writeJSON(w)
+	}
+	if x.Link != nil {
+		w.field(`"link":`)
+		x.Link.writeJSON(w)
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeResponseAsset) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeResponseAsset) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.int(intSize); ok {
+				x.ID = int(v)
+			}
+		case "required", "REQUIRED":
+			if v, ok := r.int(intSize); ok {
+				x.Required = int(v)
+			}
+		case "title", "TITLE":
+			if r.null() {
+				x.Title = nil
+			} else {
+				if x.Title == nil {
+					x.Title = poolNativeTitleResponse.Get().(*NativeTitleResponse)
+				}
+				x.Title.readJSON(r)
+			}
+		case "img", "IMG":
+			if r.null() {
+				x.Img = nil
+			} else {
+				if x.Img == nil {
+					x.Img = poolNativeImageResponse.Get().(*NativeImageResponse)
+				}
+				x.Img.readJSON(r)
+			}
+		case "video", "VIDEO":
+			if r.null() {
+				x.Video = nil
+			} else {
+				if x.Video == nil {
+					x.Video = poolNativeVideoResponse.Get().(*NativeVideoResponse)
+				}
+				x.Video.readJSON(r)
+			}
+		case "data", "DATA":
+			if r.null() {
+				x.Data = nil
+			} else {
+				if x.Data == nil {
+					x.Data = poolNativeDataResponse.Get().(*NativeDataResponse)
+				}
+				x.Data.readJSON(r)
+			}
+		case "link", "LINK":
+			if r.null() {
+				x.Link = nil
+			} else {
+				if x.Link == nil {
+					x.Link = poolNativeLink.Get().(*NativeLink)
+				}
+				x.Link.readJSON(r)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeResponseAssetExt.Get().(*NativeResponseAssetExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeResponseAssetExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeResponseAssetExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeResponseAssetExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeResponseAssetExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeTitle) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 128)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeTitle) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"len":`)
+	w.int(int64(x.Len))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeTitle) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeTitle) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "len", "LEN":
+			if v, ok := r.int(intSize); ok {
+				x.Len = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeTitleExt.Get().(*NativeTitleExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeTitleExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeTitleExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeTitleExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeTitleExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeTitleResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 192)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeTitleResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"text":`)
+	w.string(string(x.Text))
+	if x.Len != 0 {
+		w.field(`"len":`)
+		w.int(int64(x.Len))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeTitleResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeTitleResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "text", "TEXT":
+			if v, ok := r.string(); ok {
+				x.Text = v
+			}
+		case "len", "LEN":
+			if v, ok := r.int(intSize); ok {
+				x.Len = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeTitleResponseExt.Get().(*NativeTitleResponseExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeTitleResponseExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeTitleResponseExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeTitleResponseExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeTitleResponseExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeVideo) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeVideo) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"mimes":`)
+	if x.Mimes == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Mimes {
+			w.elem(i)
+			w.string(string(x.Mimes[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"minduration":`)
+	w.int(int64(x.MinDuration))
+	w.field(`"maxduration":`)
+	w.int(int64(x.MaxDuration))
+	w.field(`"protocols":`)
+	if x.Protocols == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Protocols {
+			w.elem(i)
+			w.int(int64(x.Protocols[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeVideo) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeVideo) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "mimes", "MIMES":
+			if r.null() {
+				x.Mimes = nil
+			} else if r.array(&x.Mimes) {
+				if x.Mimes == nil {
+					x.Mimes = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Mimes = grow(x.Mimes, i)
+					if v, ok := r.string(); ok {
+						x.Mimes[i] = v
+					}
+				}
+				x.Mimes = truncate(x.Mimes, i)
+			}
+		case "minduration", "MINDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.MinDuration = int(v)
+			}
+		case "maxduration", "MAXDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.MaxDuration = int(v)
+			}
+		case "protocols", "PROTOCOLS":
+			if r.null() {
+				x.Protocols = nil
+			} else if r.array(&x.Protocols) {
+				if x.Protocols == nil {
+					x.Protocols = poolProtocolSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Protocols = grow(x.Protocols, i)
+					if v, ok := r.int(intSize); ok {
+						x.Protocols[i] = Protocol(v)
+					}
+				}
+				x.Protocols = truncate(x.Protocols, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolNativeVideoExt.Get().(*NativeVideoExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeVideoExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeVideoExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeVideoExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeVideoExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x NativeVideoResponse) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 64)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *NativeVideoResponse) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"vasttag":`)
+	w.string(string(x.VASTTag))
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *NativeVideoResponse) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *NativeVideoResponse) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "vasttag", "VASTTAG":
+			if v, ok := r.string(); ok {
+				x.VASTTag = v
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x PMP) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 192)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *PMP) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"private_auction":`)
+	w.int(int64(x.PrivateAuction))
+	if len(x.Deals) != 0 {
+		w.field(`"deals":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Deals {
+			w.elem(i)
+			x.Deals[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *PMP) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *PMP) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "private_auction", "PRIVATE_AUCTION":
+			if v, ok := r.int(intSize); ok {
+				x.PrivateAuction = int(v)
+			}
+		case "deals", "DEALS":
+			if r.null() {
+				x.Deals = nil
+			} else if r.array(&x.Deals) {
+				if x.Deals == nil {
+					x.Deals = poolDealSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Deals = grow(x.Deals, i)
+					x.Deals[i].readJSON(r)
+				}
+				x.Deals = truncate(x.Deals, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolPMPExt.Get().(*PMPExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x PMPExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *PMPExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *PMPExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *PMPExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Producer) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Producer) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	w.field(`"name":`)
+	w.string(string(x.Name))
+	if len(x.Cat) != 0 {
+		w.field(`"cat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"domain":`)
+	w.string(string(x.Domain))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Producer) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Producer) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "domain", "DOMAIN":
+			if v, ok := r.string(); ok {
+				x.Domain = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolProducerExt.Get().(*ProducerExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x ProducerExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *ProducerExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *ProducerExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *ProducerExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Publisher) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 320)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Publisher) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	w.field(`"name":`)
+	w.string(string(x.Name))
+	if len(x.Cat) != 0 {
+		w.field(`"cat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"domain":`)
+	w.string(string(x.Domain))
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Publisher) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Publisher) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "domain", "DOMAIN":
+			if v, ok := r.string(); ok {
+				x.Domain = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolPublisherExt.Get().(*PublisherExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x PublisherExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *PublisherExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *PublisherExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *PublisherExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Regs) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Regs) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"coppa":`)
+	w.int(int64(x.Coppa))
+	if x.GPP != "" {
+		w.field(`"gpp":`)
+		w.string(string(x.GPP))
+	}
+	if len(x.GPPSID) != 0 {
+		w.field(`"gpp_sid":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.GPPSID {
+			w.elem(i)
+			w.int(int64(x.GPPSID[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"ext":`)
+	if x.Ext == nil {
+		w.null()
+	} else {
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Regs) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Regs) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "coppa", "COPPA":
+			if v, ok := r.int(intSize); ok {
+				x.Coppa = int(v)
+			}
+		case "gpp", "GPP":
+			if v, ok := r.string(); ok {
+				x.GPP = v
+			}
+		case "gpp_sid", "GPP_SID":
+			if r.null() {
+				x.GPPSID = nil
+			} else if r.array(&x.GPPSID) {
+				if x.GPPSID == nil {
+					x.GPPSID = poolIntSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.GPPSID = grow(x.GPPSID, i)
+					if v, ok := r.int(intSize); ok {
+						x.GPPSID[i] = int(v)
+					}
+				}
+				x.GPPSID = truncate(x.GPPSID, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolRegsExt.Get().(*RegsExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x RegsExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 128)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *RegsExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"gdpr":`)
+	w.int(int64(x.GDPR))
+	if x.USPrivacy != "" {
+		w.field(`"us_privacy":`)
+		w.string(string(x.USPrivacy))
+	}
+	w.extensions(x.Extensions, []string{"gdpr", "us_privacy"})
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *RegsExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *RegsExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "gdpr", "GDPR":
+			if v, ok := r.int(intSize); ok {
+				x.GDPR = int(v)
+			}
+		case "us_privacy", "US_PRIVACY":
+			if v, ok := r.string(); ok {
+				x.USPrivacy = v
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Request) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1344)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Request) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"id":`)
+	w.string(string(x.ID))
+	w.field(`"imp":`)
+	if x.Imp == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Imp {
+			w.elem(i)
+			x.Imp[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.App != nil {
+		w.field(`"app":`)
+		x.App.writeJSON(w)
+	}
+	if x.Site != nil {
+		w.field(`"site":`)
+		x.Site.writeJSON(w)
+	}
+	w.field(`"device":`)
+	x.Device.writeJSON(w)
+	w.field(`"format":`)
+	x.Format.writeJSON(w)
+	w.field(`"user":`)
+	x.User.writeJSON(w)
+	if x.Test != 0 {
+		w.field(`"test":`)
+		w.int(int64(x.Test))
+	}
+	if x.At != 0 {
+		w.field(`"at":`)
+		w.int(int64(x.At))
+	}
+	if x.Tmax != 0 {
+		w.field(`"tmax":`)
+		w.int(int64(x.Tmax))
+	}
+	if len(x.WSeat) != 0 {
+		w.field(`"wseat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.WSeat {
+			w.elem(i)
+			w.string(string(x.WSeat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.BSeat) != 0 {
+		w.field(`"bseat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.BSeat {
+			w.elem(i)
+			w.string(string(x.BSeat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.AllImps != 0 {
+		w.field(`"allimps":`)
+		w.int(int64(x.AllImps))
+	}
+	if len(x.Cur) != 0 {
+		w.field(`"cur":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cur {
+			w.elem(i)
+			w.string(string(x.Cur[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.Wlang) != 0 {
+		w.field(`"wlang":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Wlang {
+			w.elem(i)
+			w.string(string(x.Wlang[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.Bcat) != 0 {
+		w.field(`"bcat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Bcat {
+			w.elem(i)
+			w.string(string(x.Bcat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.BAdv) != 0 {
+		w.field(`"badv":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.BAdv {
+			w.elem(i)
+			w.string(string(x.BAdv[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.BApp) != 0 {
+		w.field(`"bapp":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.BApp {
+			w.elem(i)
+			w.string(string(x.BApp[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Source != nil {
+		w.field(`"source":`)
+		x.Source.writeJSON(w)
+	}
+	w.field(`"regs":`)
+	x.Regs.writeJSON(w)
+	w.field(`"ext":`)
+	x.Ext.writeJSON(w)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Request) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Request) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "imp", "IMP":
+			if r.null() {
+				x.Imp = nil
+			} else if r.array(&x.Imp) {
+				if x.Imp == nil {
+					x.Imp = poolImpSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Imp = grow(x.Imp, i)
+					x.Imp[i].readJSON(r)
+				}
+				x.Imp = truncate(x.Imp, i)
+			}
+		case "app", "APP":
+			if r.null() {
+				x.App = nil
+			} else {
+				if x.App == nil {
+					x.App = poolApp.Get().(*App)
+				}
+				x.App.readJSON(r)
+			}
+		case "site", "SITE":
+			if r.null() {
+				x.Site = nil
+			} else {
+				if x.Site == nil {
+					x.Site = poolSite.Get().(*Site)
+				}
+				x.Site.readJSON(r)
+			}
+		case "device", "DEVICE":
+			x.Device.readJSON(r)
+		case "format", "FORMAT":
+			x.Format.readJSON(r)
+		case "user", "USER":
+			x.User.readJSON(r)
+		case "test", "TEST":
+			if v, ok := r.int(intSize); ok {
+				x.Test = int(v)
+			}
+		case "at", "AT":
+			if v, ok := r.int(intSize); ok {
+				x.At = int(v)
+			}
+		case "tmax", "TMAX":
+			if v, ok := r.int(intSize); ok {
+				x.Tmax = int(v)
+			}
+		case "wseat", "WSEAT":
+			if r.null() {
+				x.WSeat = nil
+			} else if r.array(&x.WSeat) {
+				if x.WSeat == nil {
+					x.WSeat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.WSeat = grow(x.WSeat, i)
+					if v, ok := r.string(); ok {
+						x.WSeat[i] = v
+					}
+				}
+				x.WSeat = truncate(x.WSeat, i)
+			}
+		case "bseat", "BSEAT":
+			if r.null() {
+				x.BSeat = nil
+			} else if r.array(&x.BSeat) {
+				if x.BSeat == nil {
+					x.BSeat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.BSeat = grow(x.BSeat, i)
+					if v, ok := r.string(); ok {
+						x.BSeat[i] = v
+					}
+				}
+				x.BSeat = truncate(x.BSeat, i)
+			}
+		case "allimps", "ALLIMPS":
+			if v, ok := r.int(intSize); ok {
+				x.AllImps = int(v)
+			}
+		case "cur", "CUR":
+			if r.null() {
+				x.Cur = nil
+			} else if r.array(&x.Cur) {
+				if x.Cur == nil {
+					x.Cur = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cur = grow(x.Cur, i)
+					if v, ok := r.string(); ok {
+						x.Cur[i] = v
+					}
+				}
+				x.Cur = truncate(x.Cur, i)
+			}
+		case "wlang", "WLANG":
+			if r.null() {
+				x.Wlang = nil
+			} else if r.array(&x.Wlang) {
+				if x.Wlang == nil {
+					x.Wlang = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Wlang = grow(x.Wlang, i)
+					if v, ok := r.string(); ok {
+						x.Wlang[i] = v
+					}
+				}
+				x.Wlang = truncate(x.Wlang, i)
+			}
+		case "bcat", "BCAT":
+			if r.null() {
+				x.Bcat = nil
+			} else if r.array(&x.Bcat) {
+				if x.Bcat == nil {
+					x.Bcat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Bcat = grow(x.Bcat, i)
+					if v, ok := r.string(); ok {
+						x.Bcat[i] = v
+					}
+				}
+				x.Bcat = truncate(x.Bcat, i)
+			}
+		case "badv", "BADV":
+			if r.null() {
+				x.BAdv = nil
+			} else if r.array(&x.BAdv) {
+				if x.BAdv == nil {
+					x.BAdv = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.BAdv = grow(x.BAdv, i)
+					if v, ok := r.string(); ok {
+						x.BAdv[i] = v
+					}
+				}
+				x.BAdv = truncate(x.BAdv, i)
+			}
+		case "bapp", "BAPP":
+			if r.null() {
+				x.BApp = nil
+			} else if r.array(&x.BApp) {
+				if x.BApp == nil {
+					x.BApp = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.BApp = grow(x.BApp, i)
+					if v, ok := r.string(); ok {
+						x.BApp[i] = v
+					}
+				}
+				x.BApp = truncate(x.BApp, i)
+			}
+		case "source", "SOURCE":
+			if r.null() {
+				x.Source = nil
+			} else {
+				if x.Source == nil {
+					x.Source = poolSource.Get().(*Source)
+				}
+				x.Source.readJSON(r)
+			}
+		case "regs", "REGS":
+			x.Regs.readJSON(r)
+		case "ext", "EXT":
+			x.Ext.readJSON(r)
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x RequestExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 128)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *RequestExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"api_key":`)
+	w.string(string(x.APIKey))
+	w.field(`"session_id":`)
+	w.string(string(x.SessionID))
+	w.extensions(x.Extensions, []string{"api_key", "session_id"})
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *RequestExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *RequestExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "api_key", "API_KEY":
+			if v, ok := r.string(); ok {
+				x.APIKey = v
+			}
+		case "session_id", "SESSION_ID":
+			if v, ok := r.string(); ok {
+				x.SessionID = v
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Seatbid) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Seatbid) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.field(`"bid":`)
+	if x.Bid == nil {
+		w.null()
+	} else {
+		w.buf = append(w.buf, '[')
+		for i := range x.Bid {
+			w.elem(i)
+			x.Bid[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"seat":`)
+	w.string(string(x.Seat))
+	w.field(`"group":`)
+	w.int(int64(x.Group))
+	w.field(`"ext":`)
+	x.Ext.writeJSON(w)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Seatbid) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Seatbid) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "bid", "BID":
+			if r.null() {
+				x.Bid = nil
+			} else if r.array(&x.Bid) {
+				if x.Bid == nil {
+					x.Bid = poolBidSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Bid = grow(x.Bid, i)
+					x.Bid[i].readJSON(r)
+				}
+				x.Bid = truncate(x.Bid, i)
+			}
+		case "seat", "SEAT":
+			if v, ok := r.string(); ok {
+				x.Seat = v
+			}
+		case "group", "GROUP":
+			if v, ok := r.int(intSize); ok {
+				x.Group = int(v)
+			}
+		case "ext", "EXT":
+			x.Ext.readJSON(r)
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x SeatbidExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *SeatbidExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *SeatbidExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *SeatbidExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Segment) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Segment) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Name != "" {
+		w.field(`"name":`)
+		w.string(string(x.Name))
+	}
+	if x.Value != "" {
+		w.field(`"value":`)
+		w.string(string(x.Value))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Segment) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Segment) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "value", "VALUE":
+			if v, ok := r.string(); ok {
+				x.Value = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolSegmentExt.Get().(*SegmentExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x SegmentExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *SegmentExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *SegmentExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *SegmentExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Site) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1024)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Site) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Name != "" {
+		w.field(`"name":`)
+		w.string(string(x.Name))
+	}
+	if x.Domain != "" {
+		w.field(`"domain":`)
+		w.string(string(x.Domain))
+	}
+	if len(x.Cat) != 0 {
+		w.field(`"cat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Cat {
+			w.elem(i)
+			w.string(string(x.Cat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.SectionCat) != 0 {
+		w.field(`"sectioncat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.SectionCat {
+			w.elem(i)
+			w.string(string(x.SectionCat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if len(x.PageCat) != 0 {
+		w.field(`"pagecat":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.PageCat {
+			w.elem(i)
+			w.string(string(x.PageCat[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Page != "" {
+		w.field(`"page":`)
+		w.string(string(x.Page))
+	}
+	if x.Ref != "" {
+		w.field(`"ref":`)
+		w.string(string(x.Ref))
+	}
+	if x.Search != "" {
+		w.field(`"search":`)
+		w.string(string(x.Search))
+	}
+	if x.Mobile != 0 {
+		w.field(`"mobile":`)
+		w.int(int64(x.Mobile))
+	}
+	if x.AMP != 0 {
+		w.field(`"amp":`)
+		w.int(int64(x.AMP))
+	}
+	if x.PrivacyPolicy != 0 {
+		w.field(`"privacypolicy":`)
+		w.int(int64(x.PrivacyPolicy))
+	}
+	w.field(`"publisher":`)
+	x.Publisher.writeJSON(w)
+	if x.Content != nil {
+		w.field(`"content":`)
+		x.Content.writeJSON(w)
+	}
+	if len(x.Keywords) != 0 {
+		w.field(`"keywords":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Keywords {
+			w.elem(i)
+			w.string(string(x.Keywords[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Site) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Site) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "name", "NAME":
+			if v, ok := r.string(); ok {
+				x.Name = v
+			}
+		case "domain", "DOMAIN":
+			if v, ok := r.string(); ok {
+				x.Domain = v
+			}
+		case "cat", "CAT":
+			if r.null() {
+				x.Cat = nil
+			} else if r.array(&x.Cat) {
+				if x.Cat == nil {
+					x.Cat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Cat = grow(x.Cat, i)
+					if v, ok := r.string(); ok {
+						x.Cat[i] = v
+					}
+				}
+				x.Cat = truncate(x.Cat, i)
+			}
+		case "sectioncat", "SECTIONCAT":
+			if r.null() {
+				x.SectionCat = nil
+			} else if r.array(&x.SectionCat) {
+				if x.SectionCat == nil {
+					x.SectionCat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.SectionCat = grow(x.SectionCat, i)
+					if v, ok := r.string(); ok {
+						x.SectionCat[i] = v
+					}
+				}
+				x.SectionCat = truncate(x.SectionCat, i)
+			}
+		case "pagecat", "PAGECAT":
+			if r.null() {
+				x.PageCat = nil
+			} else if r.array(&x.PageCat) {
+				if x.PageCat == nil {
+					x.PageCat = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.PageCat = grow(x.PageCat, i)
+					if v, ok := r.string(); ok {
+						x.PageCat[i] = v
+					}
+				}
+				x.PageCat = truncate(x.PageCat, i)
+			}
+		case "page", "PAGE":
+			if v, ok := r.string(); ok {
+				x.Page = v
+			}
+		case "ref", "REF":
+			if v, ok := r.string(); ok {
+				x.Ref = v
+			}
+		case "search", "SEARCH":
+			if v, ok := r.string(); ok {
+				x.Search = v
+			}
+		case "mobile", "MOBILE":
+			if v, ok := r.int(intSize); ok {
+				x.Mobile = int(v)
+			}
+		case "amp", "AMP":
+			if v, ok := r.int(intSize); ok {
+				x.AMP = int(v)
+			}
+		case "privacypolicy", "PRIVACYPOLICY":
+			if v, ok := r.int(intSize); ok {
+				x.PrivacyPolicy = int(v)
+			}
+		case "publisher", "PUBLISHER":
+			x.Publisher.readJSON(r)
+		case "content", "CONTENT":
+			if r.null() {
+				x.Content = nil
+			} else {
+				if x.Content == nil {
+					x.Content = poolContent.Get().(*Content)
+				}
+				x.Content.readJSON(r)
+			}
+		case "keywords", "KEYWORDS":
+			if r.null() {
+				x.Keywords = nil
+			} else if r.array(&x.Keywords) {
+				if x.Keywords == nil {
+					x.Keywords = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Keywords = grow(x.Keywords, i)
+					if v, ok := r.string(); ok {
+						x.Keywords[i] = v
+					}
+				}
+				x.Keywords = truncate(x.Keywords, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolSiteExt.Get().(*SiteExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x SiteExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *SiteExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *SiteExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *SiteExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Source) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 256)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Source) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.FD != 0 {
+		w.field(`"fd":`)
+		w.int(int64(x.FD))
+	}
+	if x.TID != "" {
+		w.field(`"tid":`)
+		w.string(string(x.TID))
+	}
+	if x.PChain != "" {
+		w.field(`"pchain":`)
+		w.string(string(x.PChain))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Source) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Source) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "fd", "FD":
+			if v, ok := r.int(intSize); ok {
+				x.FD = int(v)
+			}
+		case "tid", "TID":
+			if v, ok := r.string(); ok {
+				x.TID = v
+			}
+		case "pchain", "PCHAIN":
+			if v, ok := r.string(); ok {
+				x.PChain = v
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolSourceExt.Get().(*SourceExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x SourceExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 128)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *SourceExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Omidpn != "" {
+		w.field(`"omidpn":`)
+		w.string(string(x.Omidpn))
+	}
+	if x.Omidpv != "" {
+		w.field(`"omidpv":`)
+		w.string(string(x.Omidpv))
+	}
+	w.extensions(x.Extensions, []string{"omidpn", "omidpv"})
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *SourceExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *SourceExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "omidpn", "OMIDPN":
+			if v, ok := r.string(); ok {
+				x.Omidpn = v
+			}
+		case "omidpv", "OMIDPV":
+			if v, ok := r.string(); ok {
+				x.Omidpv = v
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x User) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 640)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *User) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.ID != "" {
+		w.field(`"id":`)
+		w.string(string(x.ID))
+	}
+	if x.Age != 0 {
+		w.field(`"age":`)
+		w.int(int64(x.Age))
+	}
+	if x.BuyerUID != "" {
+		w.field(`"buyeruid":`)
+		w.string(string(x.BuyerUID))
+	}
+	if x.YOB != 0 {
+		w.field(`"yob":`)
+		w.int(int64(x.YOB))
+	}
+	if x.Gender != "" {
+		w.field(`"gender":`)
+		w.string(string(x.Gender))
+	}
+	if x.Keywords != "" {
+		w.field(`"keywords":`)
+		w.string(string(x.Keywords))
+	}
+	if x.CustomData != "" {
+		w.field(`"custom_data":`)
+		w.string(string(x.CustomData))
+	}
+	if x.Geo != nil {
+		w.field(`"geo":`)
+		x.Geo.writeJSON(w)
+	}
+	if len(x.Data) != 0 {
+		w.field(`"data":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Data {
+			w.elem(i)
+			x.Data[i].writeJSON(w)
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"ext":`)
+	if x.Ext == nil {
+		w.null()
+	} else {
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *User) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *User) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "id", "ID":
+			if v, ok := r.string(); ok {
+				x.ID = v
+			}
+		case "age", "AGE":
+			if v, ok := r.int(intSize); ok {
+				x.Age = int(v)
+			}
+		case "buyeruid", "BUYERUID":
+			if v, ok := r.string(); ok {
+				x.BuyerUID = v
+			}
+		case "yob", "YOB":
+			if v, ok := r.int(intSize); ok {
+				x.YOB = int(v)
+			}
+		case "gender", "GENDER":
+			if v, ok := r.string(); ok {
+				x.Gender = v
+			}
+		case "keywords", "KEYWORDS":
+			if v, ok := r.string(); ok {
+				x.Keywords = v
+			}
+		case "custom_data", "CUSTOM_DATA":
+			if v, ok := r.string(); ok {
+				x.CustomData = v
+			}
+		case "geo", "GEO":
+			if r.null() {
+				x.Geo = nil
+			} else {
+				if x.Geo == nil {
+					x.Geo = poolGeo.Get().(*Geo)
+				}
+				x.Geo.readJSON(r)
+			}
+		case "data", "DATA":
+			if r.null() {
+				x.Data = nil
+			} else if r.array(&x.Data) {
+				if x.Data == nil {
+					x.Data = poolDataSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Data = grow(x.Data, i)
+					x.Data[i].readJSON(r)
+				}
+				x.Data = truncate(x.Data, i)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolUserExt.Get().(*UserExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x UserExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 192)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *UserExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.Consent != "" {
+		w.field(`"consent":`)
+		w.string(string(x.Consent))
+	}
+	if x.Age != 0 {
+		w.field(`"age":`)
+		w.int(int64(x.Age))
+	}
+	if x.DidConsent != 0 {
+		w.field(`"did_consent":`)
+		w.int(int64(x.DidConsent))
+	}
+	w.extensions(x.Extensions, []string{"consent", "age", "did_consent"})
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *UserExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *UserExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "consent", "CONSENT":
+			if v, ok := r.string(); ok {
+				x.Consent = v
+			}
+		case "age", "AGE":
+			if v, ok := r.int(intSize); ok {
+				x.Age = int(v)
+			}
+		case "did_consent", "DID_CONSENT":
+			if v, ok := r.int(intSize); ok {
+				x.DidConsent = int(v)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x Video) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 1216)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *Video) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	if x.BidFloor != nil {
+		w.field(`"bidfloor":`)
+		w.float(float64(*x.BidFloor), 64)
+	}
+	if len(x.Mimes) != 0 {
+		w.field(`"mimes":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Mimes {
+			w.elem(i)
+			w.string(string(x.Mimes[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"minduration":`)
+	w.int(int64(x.Minduration))
+	if x.Maxduration != 0 {
+		w.field(`"maxduration":`)
+		w.int(int64(x.Maxduration))
+	}
+	if len(x.Protocols) != 0 {
+		w.field(`"protocols":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Protocols {
+			w.elem(i)
+			w.int(int64(x.Protocols[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.W != 0 {
+		w.field(`"w":`)
+		w.int(int64(x.W))
+	}
+	if x.H != 0 {
+		w.field(`"h":`)
+		w.int(int64(x.H))
+	}
+	w.field(`"startdelay":`)
+	w.int(int64(x.StartDelay))
+	if x.Placement != 0 {
+		w.field(`"placement":`)
+		w.int(int64(x.Placement))
+	}
+	if x.Linearity != 0 {
+		w.field(`"linearity":`)
+		w.int(int64(x.Linearity))
+	}
+	if len(x.Playbackmethod) != 0 {
+		w.field(`"playbackmethod":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Playbackmethod {
+			w.elem(i)
+			w.int(int64(x.Playbackmethod[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	w.field(`"skip":`)
+	w.int(int64(x.Skip))
+	if len(x.Delivery) != 0 {
+		w.field(`"Delivery":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.Delivery {
+			w.elem(i)
+			w.int(int64(x.Delivery[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.Pos != 0 {
+		w.field(`"pos":`)
+		w.int(int64(x.Pos))
+	}
+	if len(x.API) != 0 {
+		w.field(`"api":`)
+		w.buf = append(w.buf, '[')
+		for i := range x.API {
+			w.elem(i)
+			w.int(int64(x.API[i]))
+		}
+		w.buf = append(w.buf, ']')
+	}
+	if x.MinBitRate != 0 {
+		w.field(`"minbitrate":`)
+		w.int(int64(x.MinBitRate))
+	}
+	if x.MaxBitRate != 0 {
+		w.field(`"maxbitrate":`)
+		w.int(int64(x.MaxBitRate))
+	}
+	if x.Boxingallowed != 0 {
+		w.field(`"boxingallowed":`)
+		w.int(int64(x.Boxingallowed))
+	}
+	if x.Ext != nil {
+		w.field(`"ext":`)
+		x.Ext.writeJSON(w)
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *Video) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *Video) readJSON(r *jsonReader) {
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		case "bidfloor", "BIDFLOOR":
+			if r.null() {
+				x.BidFloor = nil
+			} else {
+				if x.BidFloor == nil {
+					x.BidFloor = new(float64)
+				}
+				if v, ok := r.float(64); ok {
+					*x.BidFloor = v
+				}
+			}
+		case "mimes", "MIMES":
+			if r.null() {
+				x.Mimes = nil
+			} else if r.array(&x.Mimes) {
+				if x.Mimes == nil {
+					x.Mimes = poolStringSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Mimes = grow(x.Mimes, i)
+					if v, ok := r.string(); ok {
+						x.Mimes[i] = v
+					}
+				}
+				x.Mimes = truncate(x.Mimes, i)
+			}
+		case "minduration", "MINDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.Minduration = int(v)
+			}
+		case "maxduration", "MAXDURATION":
+			if v, ok := r.int(intSize); ok {
+				x.Maxduration = int(v)
+			}
+		case "protocols", "PROTOCOLS":
+			if r.null() {
+				x.Protocols = nil
+			} else if r.array(&x.Protocols) {
+				if x.Protocols == nil {
+					x.Protocols = poolProtocolSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Protocols = grow(x.Protocols, i)
+					if v, ok := r.int(intSize); ok {
+						x.Protocols[i] = Protocol(v)
+					}
+				}
+				x.Protocols = truncate(x.Protocols, i)
+			}
+		case "w", "W":
+			if v, ok := r.int(intSize); ok {
+				x.W = int(v)
+			}
+		case "h", "H":
+			if v, ok := r.int(intSize); ok {
+				x.H = int(v)
+			}
+		case "startdelay", "STARTDELAY":
+			if v, ok := r.int(intSize); ok {
+				x.StartDelay = StartDelay(v)
+			}
+		case "placement", "PLACEMENT":
+			if v, ok := r.int(intSize); ok {
+				x.Placement = VideoPlacement(v)
+			}
+		case "linearity", "LINEARITY":
+			if v, ok := r.int(intSize); ok {
+				x.Linearity = VideoLinearity(v)
+			}
+		case "playbackmethod", "PLAYBACKMETHOD":
+			if r.null() {
+				x.Playbackmethod = nil
+			} else if r.array(&x.Playbackmethod) {
+				if x.Playbackmethod == nil {
+					x.Playbackmethod = poolPlaybackMethodSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Playbackmethod = grow(x.Playbackmethod, i)
+					if v, ok := r.int(intSize); ok {
+						x.Playbackmethod[i] = PlaybackMethod(v)
+					}
+				}
+				x.Playbackmethod = truncate(x.Playbackmethod, i)
+			}
+		case "skip", "SKIP":
+			if v, ok := r.int(intSize); ok {
+				x.Skip = int(v)
+			}
+		case "Delivery", "DELIVERY":
+			if r.null() {
+				x.Delivery = nil
+			} else if r.array(&x.Delivery) {
+				if x.Delivery == nil {
+					x.Delivery = poolContentDeliverySlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.Delivery = grow(x.Delivery, i)
+					if v, ok := r.int(intSize); ok {
+						x.Delivery[i] = ContentDelivery(v)
+					}
+				}
+				x.Delivery = truncate(x.Delivery, i)
+			}
+		case "pos", "POS":
+			if v, ok := r.int(intSize); ok {
+				x.Pos = AdPosition(v)
+			}
+		case "api", "API":
+			if r.null() {
+				x.API = nil
+			} else if r.array(&x.API) {
+				if x.API == nil {
+					x.API = poolAPIFrameworkSlice.get()
+				}
+				i := 0
+				for ; r.elem(i); i++ {
+					x.API = grow(x.API, i)
+					if v, ok := r.int(intSize); ok {
+						x.API[i] = APIFramework(v)
+					}
+				}
+				x.API = truncate(x.API, i)
+			}
+		case "minbitrate", "MINBITRATE":
+			if v, ok := r.int(intSize); ok {
+				x.MinBitRate = int(v)
+			}
+		case "maxbitrate", "MAXBITRATE":
+			if v, ok := r.int(intSize); ok {
+				x.MaxBitRate = int(v)
+			}
+		case "boxingallowed", "BOXINGALLOWED":
+			if v, ok := r.int(intSize); ok {
+				x.Boxingallowed = int(v)
+			}
+		case "ext", "EXT":
+			if r.null() {
+				x.Ext = nil
+			} else {
+				if x.Ext == nil {
+					x.Ext = poolVideoExt.Get().(*VideoExt)
+				}
+				x.Ext.readJSON(r)
+			}
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.skip()
+		}
+	}
+}
+
+// MarshalJSON encodes x as encoding/json does from its tags
+func (x VideoExt) MarshalJSON() ([]byte, error) {
+	w := jsonWriter{buf: make([]byte, 0, 0)}
+	x.writeJSON(&w)
+	return w.buf, w.err
+}
+
+func (x *VideoExt) writeJSON(w *jsonWriter) {
+	w.buf = append(w.buf, '{')
+	w.extensions(x.Extensions, nil)
+	w.buf = append(w.buf, '}')
+}
+
+// UnmarshalJSON decodes x as encoding/json does from its tags
+func (x *VideoExt) UnmarshalJSON(b []byte) error {
+	r := jsonReader{data: b}
+	x.readJSON(&r)
+	return r.done()
+}
+
+func (x *VideoExt) readJSON(r *jsonReader) {
+	r.extensions(&x.Extensions)
+	for key, ok := r.object(x); ok; key, ok = r.next() {
+		name := key
+	match:
+		switch string(name) {
+		default:
+			// keys match fields case insensitively, as in encoding/json
+			if name = foldKey(name); name != nil {
+				goto match
+			}
+			r.extension(&x.Extensions, x, key)
+		}
+	}
+}
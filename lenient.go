@@ -0,0 +1,205 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Coercion is a value of the wrong type met by a lenient decoding. Path is the JSON path of the value as keyed
+// in the data, e.g. imp[0].banner.w, Value the value as sent and Type the type of the field it was coerced to.
+// A Dropped value couldn't be coerced and was skipped, as a value of another type
+type Coercion struct {
+	Path    string `json:"path"`
+	Value   string `json:"value"`
+	Type    string `json:"type"`
+	Dropped bool   `json:"dropped,omitempty"`
+}
+
+func (c Coercion) String() string {
+	if c.Dropped {
+		return fmt.Sprintf("%s: dropped %s, not a %s", c.Path, c.Value, c.Type)
+	}
+	return fmt.Sprintf("%s: coerced %s to %s", c.Path, c.Value, c.Type)
+}
+
+// UnmarshalLenient decodes data into r as UnmarshalJSON does, but rather than failing on a value of the wrong
+// type it coerces the value to the type of its field: numbers and bools in strings, such as "320" or "true",
+// are read as such, bools are read as the numbers 1 and 0 and numbers as bools by being other than 0, and
+// numbers and bools are read into strings as they're written. Values that can't be coerced, such as a string
+// that isn't a number for a number, are dropped. Every coercion and drop is returned, the error is for data
+// that isn't valid JSON
+func (r *Request) UnmarshalLenient(data []byte) ([]Coercion, error) {
+	return unmarshalLenient(data, r.readJSON)
+}
+
+// UnmarshalLenient decodes data into r as the UnmarshalLenient of Request does
+func (r *BidResponse) UnmarshalLenient(data []byte) ([]Coercion, error) {
+	return unmarshalLenient(data, r.readJSON)
+}
+
+func unmarshalLenient(data []byte, read func(r *jsonReader)) ([]Coercion, error) {
+	r := jsonReader{data: data, lenient: true}
+	read(&r)
+	return r.coercions, r.done()
+}
+
+// pathElem is an object key or array index of the path of the value being read
+type pathElem struct {
+	key   []byte
+	index int
+	array bool
+}
+
+func (r *jsonReader) pathString() string {
+	var b strings.Builder
+	for i, e := range r.path {
+		switch {
+		case e.array:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(e.index))
+			b.WriteByte(']')
+		case i > 0:
+			b.WriteByte('.')
+			fallthrough
+		default:
+			b.Write(e.key)
+		}
+	}
+	return b.String()
+}
+
+// coerce records that the value read from start was coerced to the type
+func (r *jsonReader) coerce(start int, typ string) {
+	r.coercions = append(r.coercions, Coercion{Path: r.pathString(), Value: string(r.data[start:r.pos]), Type: typ})
+}
+
+// drop records that the value read from start was dropped as it couldn't be coerced to t
+func (r *jsonReader) drop(start int, t reflect.Type) {
+	if r.err != nil {
+		return
+	}
+	r.coerce(start, t.String())
+	r.coercions[len(r.coercions)-1].Dropped = true
+}
+
+// coerceString reads a number or bool as the string it's written as
+func (r *jsonReader) coerceString() (string, bool) {
+	start := r.pos
+	switch r.data[r.pos] {
+	case 't':
+		r.literal("true")
+	case 'f':
+		r.literal("false")
+	case '{', '[':
+		r.mismatch(stringType)
+		return "", false
+	default:
+		r.scanNumber()
+	}
+	if r.err != nil {
+		return "", false
+	}
+	r.coerce(start, stringType.String())
+	return string(r.data[start:r.pos]), true
+}
+
+// coerceBool reads a number as true when it's other than 0, and a string as strconv.ParseBool does
+func (r *jsonReader) coerceBool() (bool, bool) {
+	start := r.pos
+	var v bool
+	switch r.data[r.pos] {
+	case '"':
+		s, ok := r.unquote()
+		if !ok {
+			return false, false
+		}
+		var err error
+		if v, err = strconv.ParseBool(strings.TrimSpace(string(s))); err != nil {
+			r.drop(start, boolType)
+			return false, false
+		}
+	case '{', '[':
+		r.mismatch(boolType)
+		return false, false
+	default:
+		if r.scanNumber(); r.err != nil {
+			return false, false
+		}
+		f, err := strconv.ParseFloat(string(r.data[start:r.pos]), 64)
+		v = err != nil || f != 0 // a number too large for a float64 isn't 0 either
+	}
+	r.coerce(start, boolType.String())
+	return v, true
+}
+
+// coerceNumber returns the number in a string, or 1 or 0 for a bool, for int, uint or float to read. The
+// coercion is recorded here, and marked dropped by them if the number doesn't fit the type
+func (r *jsonReader) coerceNumber(kind reflect.Kind, bits int) ([]byte, bool) {
+	r.start = r.pos
+	var b []byte
+	switch r.data[r.pos] {
+	case '"':
+		s, ok := r.unquote()
+		if !ok {
+			return nil, false
+		}
+		b = bytes.TrimSpace(s)
+		// valid JSON starting as a number can only be one
+		if len(b) == 0 || (b[0] != '-' && (b[0] < '0' || b[0] > '9')) || !json.Valid(b) {
+			r.drop(r.start, numberType(kind, bits))
+			return nil, false
+		}
+	case 't':
+		r.literal("true")
+		b = []byte{'1'}
+	case 'f':
+		r.literal("false")
+		b = []byte{'0'}
+	default:
+		r.mismatch(numberType(kind, bits))
+		return nil, false
+	}
+	if r.err != nil {
+		return nil, false
+	}
+	r.coerce(r.start, numberType(kind, bits).String())
+	r.coerced = true
+	return b, true
+}
+
+// coerceInt reads a number with a fraction or exponent that's a whole number fitting the size, such as 320.0
+func (r *jsonReader) coerceInt(b []byte, bits int) (int64, bool) {
+	t := numberType(reflect.Int, bits)
+	f, err := strconv.ParseFloat(string(b), 64)
+	limit := math.Ldexp(1, bits-1)
+	if err != nil || f != math.Trunc(f) || f < -limit || f >= limit {
+		r.numberMismatch(b, t)
+		return 0, false
+	}
+	r.coerceWhole(t)
+	return int64(f), true
+}
+
+// coerceUint reads a number that's a whole number fitting the size as coerceInt does
+func (r *jsonReader) coerceUint(b []byte, bits int) (uint64, bool) {
+	t := numberType(reflect.Uint, bits)
+	f, err := strconv.ParseFloat(string(b), 64)
+	if err != nil || f != math.Trunc(f) || f < 0 || f >= math.Ldexp(1, bits) {
+		r.numberMismatch(b, t)
+		return 0, false
+	}
+	r.coerceWhole(t)
+	return uint64(f), true
+}
+
+// coerceWhole records the coercion of a whole number, unless it came from a string and is recorded already
+func (r *jsonReader) coerceWhole(t reflect.Type) {
+	if !r.coerced {
+		r.coerce(r.start, t.String())
+	}
+}
@@ -0,0 +1,247 @@
+package twofive
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalLenient(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  string
+		check func(r *Request) bool
+		want  []Coercion
+	}{
+		{
+			name:  "string for int",
+			data:  `{"imp":[{"id":"1","banner":{"w":"320","h":" 480 "}}]}`,
+			check: func(r *Request) bool { return r.Imp[0].Banner.W == 320 && r.Imp[0].Banner.H == 480 },
+			want: []Coercion{
+				{Path: "imp[0].banner.w", Value: `"320"`, Type: "int64"},
+				{Path: "imp[0].banner.h", Value: `" 480 "`, Type: "int64"},
+			},
+		},
+		{
+			name:  "string for float",
+			data:  `{"imp":[{"id":"1","bidfloor":"1.5"}]}`,
+			check: func(r *Request) bool { return r.Imp[0].BidFloor == 1.5 },
+			want:  []Coercion{{Path: "imp[0].bidfloor", Value: `"1.5"`, Type: "float64"}},
+		},
+		{
+			name:  "bool for int",
+			data:  `{"imp":[{"id":"1","secure":true,"instl":false}]}`,
+			check: func(r *Request) bool { return r.Imp[0].Secure == 1 && r.Imp[0].Instl == 0 },
+			want: []Coercion{
+				{Path: "imp[0].secure", Value: `true`, Type: "int64"},
+				{Path: "imp[0].instl", Value: `false`, Type: "int64"},
+			},
+		},
+		{
+			name:  "whole number for int",
+			data:  `{"imp":[{"id":"1","banner":{"w":320.0,"h":"4.8e2"}}]}`,
+			check: func(r *Request) bool { return r.Imp[0].Banner.W == 320 && r.Imp[0].Banner.H == 480 },
+			want: []Coercion{
+				{Path: "imp[0].banner.w", Value: `320.0`, Type: "int64"},
+				{Path: "imp[0].banner.h", Value: `"4.8e2"`, Type: "int64"},
+			},
+		},
+		{
+			name:  "number and bool for string",
+			data:  `{"id":123,"app":{"ver":4.2,"name":true}}`,
+			check: func(r *Request) bool { return r.ID == "123" && r.App.Ver == "4.2" && r.App.Name == "true" },
+			want: []Coercion{
+				{Path: "id", Value: `123`, Type: "string"},
+				{Path: "app.ver", Value: `4.2`, Type: "string"},
+				{Path: "app.name", Value: `true`, Type: "string"},
+			},
+		},
+		{
+			name: "not a number",
+			data: `{"source":{"tid":"abc-uuid"},"imp":[{"id":"1","banner":{"w":"1.5","h":"+1"}}]}`,
+			check: func(r *Request) bool {
+				return r.Source.TID == "abc-uuid" && r.Imp[0].Banner.W == 0 && r.Imp[0].Banner.H == 0
+			},
+			want: []Coercion{
+				{Path: "imp[0].banner.w", Value: `"1.5"`, Type: "int64", Dropped: true},
+				{Path: "imp[0].banner.h", Value: `"+1"`, Type: "int64", Dropped: true},
+			},
+		},
+		{
+			name: "objects and arrays",
+			data: `{"device":{"ua":{"a":[1]},"geo":[]},"imp":[{"id":"1"},{"id":"2","banner":{"format":{"w":1}}}]}`,
+			check: func(r *Request) bool {
+				return r.Device.Ua == "" && r.Imp[1].Banner.Format == nil
+			},
+			want: []Coercion{
+				{Path: "device.ua", Value: `{"a":[1]}`, Type: "string", Dropped: true},
+				{Path: "device.geo", Value: `[]`, Type: "twofive.Geo", Dropped: true},
+				{Path: "imp[1].banner.format", Value: `{"w":1}`, Type: "[]twofive.Format", Dropped: true},
+			},
+		},
+		{
+			name:  "nested arrays",
+			data:  `{"imp":[{"id":"1"},{"id":"2","banner":{"format":[{"w":300},{"w":"250"}],"api":[3,"5"]}}]}`,
+			check: func(r *Request) bool { return r.Imp[1].Banner.Format[1].W == 250 && r.Imp[1].Banner.API[1] == 5 },
+			want: []Coercion{
+				{Path: "imp[1].banner.format[1].w", Value: `"250"`, Type: "int64"},
+				{Path: "imp[1].banner.api[1]", Value: `"5"`, Type: "int64"},
+			},
+		},
+		{
+			name:  "ext",
+			data:  `{"regs":{"ext":{"gdpr":"1","other":{"a":"b"}}}}`,
+			check: func(r *Request) bool { return r.Regs.Ext.GDPR == 1 },
+			want:  []Coercion{{Path: "regs.ext.gdpr", Value: `"1"`, Type: "int64"}},
+		},
+		{
+			name:  "registered ext",
+			data:  `{"imp":[{"id":"1","ext":{"prebid":{"bidder":[]}}}]}`,
+			check: func(r *Request) bool { _, ok := r.Imp[0].Ext.TypedExt("prebid"); return !ok },
+			want:  []Coercion{{Path: "imp[0].ext.prebid", Value: `{"bidder":[]}`, Type: "json.RawMessage"}},
+		},
+	}
+
+	type prebid struct {
+		Bidder map[string]json.RawMessage `json:"bidder"`
+	}
+	RegisterExt[*Imp]("prebid", prebid{})
+	defer RegisterExt[*Imp]("prebid", nil)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req Request
+			got, err := req.UnmarshalLenient([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("coercions = %+v, want %+v", got, tt.want)
+			}
+			if !tt.check(&req) {
+				t.Errorf("decoded %+v", req)
+			}
+
+			if err := json.Unmarshal([]byte(tt.data), new(Request)); err == nil {
+				t.Error("the data decodes strictly")
+			}
+		})
+	}
+}
+
+func TestUnmarshalLenientFixtures(t *testing.T) {
+	for _, file := range jsonFixtures {
+		if !strings.HasSuffix(file, "_request.json") {
+			continue
+		}
+		t.Run(file, func(t *testing.T) {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got Request
+			coercions, err := got.UnmarshalLenient(data)
+			if err != nil || coercions != nil {
+				t.Fatalf("UnmarshalLenient() = %v, %v", coercions, err)
+			}
+			if want := loadRequest(t, file); !reflect.DeepEqual(&got, want) {
+				t.Errorf("got %+v\nwant %+v", got, want)
+			}
+		})
+	}
+
+	var req Request
+	if _, err := req.UnmarshalLenient([]byte(`{"id":"1",`)); err == nil {
+		t.Error("invalid JSON decoded leniently")
+	}
+}
+
+// TestLenientScalars covers the coercions of the kinds of the reader the objects don't have fields of
+func TestLenientScalars(t *testing.T) {
+	tests := []struct {
+		data    string
+		read    func(r *jsonReader) interface{}
+		want    interface{}
+		dropped bool
+	}{
+		{data: `"true"`, read: readBool, want: true},
+		{data: `"0"`, read: readBool, want: false},
+		{data: `2`, read: readBool, want: true},
+		{data: `0.0`, read: readBool, want: false},
+		{data: `"yes"`, read: readBool, want: false, dropped: true},
+		{data: `"7"`, read: readUint8, want: uint64(7)},
+		{data: `true`, read: readUint8, want: uint64(1)},
+		{data: `255.0`, read: readUint8, want: uint64(255)},
+		{data: `256`, read: readUint8, want: uint64(0), dropped: true},
+		{data: `"-1"`, read: readUint8, want: uint64(0), dropped: true},
+		{data: `"-128"`, read: readInt8, want: int64(-128)},
+		{data: `"128"`, read: readInt8, want: int64(0), dropped: true},
+	}
+
+	for _, tt := range tests {
+		r := jsonReader{data: []byte(tt.data), lenient: true}
+		got := tt.read(&r)
+		if err := r.done(); err != nil {
+			t.Fatalf("%s: %v", tt.data, err)
+		}
+		if got != tt.want || len(r.coercions) != 1 || r.coercions[0].Dropped != tt.dropped {
+			t.Errorf("%s: got %v with %+v, want %v", tt.data, got, r.coercions, tt.want)
+		}
+	}
+}
+
+func readBool(r *jsonReader) interface{} {
+	v, _ := r.bool()
+	return v
+}
+
+func readUint8(r *jsonReader) interface{} {
+	v, _ := r.uint(8)
+	return v
+}
+
+func readInt8(r *jsonReader) interface{} {
+	v, _ := r.int(8)
+	return v
+}
+
+func FuzzUnmarshalLenient(f *testing.F) {
+	for _, file := range jsonFixtures {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Add([]byte(`{"id":1,"imp":[{"id":"1","secure":true,"banner":{"w":"320","format":[{"w":"1.0"},[]]}}],"ext":{"k":[1]}}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var got Request
+		coercions, err := got.UnmarshalLenient(data)
+		if (err == nil) != json.Valid(data) {
+			t.Fatalf("UnmarshalLenient(%s) error = %v", data, err)
+		}
+
+		var want Request
+		if json.Unmarshal(data, &want) == nil {
+			if coercions != nil || !reflect.DeepEqual(got, want) {
+				t.Fatalf("UnmarshalLenient(%s) = %+v with %v, want %+v", data, got, coercions, want)
+			}
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		// what's decoded leniently decodes strictly once encoded
+		b, err := got.MarshalJSON()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(b, new(Request)); err != nil {
+			t.Fatalf("UnmarshalLenient(%s) encodes as %s: %v", data, b, err)
+		}
+	})
+}
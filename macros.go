@@ -0,0 +1,156 @@
+package twofive
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+// The substitution macros of section 4.4 of the spec, as used as keys of AuctionContext.Encoders
+const (
+	MacroAuctionID       = "AUCTION_ID"
+	MacroAuctionBidID    = "AUCTION_BID_ID"
+	MacroAuctionImpID    = "AUCTION_IMP_ID"
+	MacroAuctionSeatID   = "AUCTION_SEAT_ID"
+	MacroAuctionAdID     = "AUCTION_AD_ID"
+	MacroAuctionPrice    = "AUCTION_PRICE"
+	MacroAuctionCurrency = "AUCTION_CURRENCY"
+	MacroAuctionMBR      = "AUCTION_MBR"
+	MacroAuctionLoss     = "AUCTION_LOSS"
+)
+
+// MacroEncoder encodes the value of a macro before it's substituted, e.g. to encrypt the price
+type MacroEncoder func(value string) string
+
+// Base64Encoder encodes the value as unpadded URL safe base64, as the spec's :B64 suffix asks for
+func Base64Encoder(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// AuctionContext is the outcome of the auction a bid took part in, the values the macros expand to. ImpID and
+// AdID fall back to the impid and adid of the bid being expanded when empty
+type AuctionContext struct {
+	AuctionID string     // ${AUCTION_ID}, the id of the request
+	BidID     string     // ${AUCTION_BID_ID}, the bidid of the response
+	ImpID     string     // ${AUCTION_IMP_ID}
+	SeatID    string     // ${AUCTION_SEAT_ID}
+	AdID      string     // ${AUCTION_AD_ID}
+	Price     float64    // ${AUCTION_PRICE}, the clearing price
+	Currency  string     // ${AUCTION_CURRENCY}
+	MBR       float64    // ${AUCTION_MBR}, the market bid ratio, clearing price over bid price
+	Loss      LossReason // ${AUCTION_LOSS}
+
+	// Encoders are applied to the value of the macro of their key, e.g. MacroAuctionPrice, before substitution
+	Encoders map[string]MacroEncoder
+}
+
+// AuctionContext returns the context of the bid in the auction it took part in, priced in the currency cur
+func (o BidOutcome) AuctionContext(cur string) AuctionContext {
+	ctx := AuctionContext{
+		ImpID:    o.ImpID,
+		SeatID:   o.Seat,
+		Price:    o.Price,
+		Currency: cur,
+		Loss:     o.Reason,
+	}
+	if o.Response != nil {
+		ctx.AuctionID, ctx.BidID = o.Response.ID, o.Response.BidID
+	}
+	if o.Bid != nil {
+		ctx.AdID = o.Bid.Adid
+		if o.Bid.Price > 0 && o.Reason == LossBidWon {
+			ctx.MBR = o.Price / o.Bid.Price
+		}
+	}
+	return ctx
+}
+
+// ExpandMacros substitutes the auction macros in the nurl, burl, lurl and adm of the bid. A macro suffixed
+// :B64, e.g. ${AUCTION_PRICE:B64}, is base64 encoded after any encoder of the context. Unknown macros are
+// left intact
+func (b *Bid) ExpandMacros(ctx AuctionContext) {
+	if ctx.ImpID == "" {
+		ctx.ImpID = b.ImpID
+	}
+	if ctx.AdID == "" {
+		ctx.AdID = b.Adid
+	}
+
+	b.NURL = ctx.Expand(b.NURL)
+	b.BURL = ctx.Expand(b.BURL)
+	b.LURL = ctx.Expand(b.LURL)
+	b.Adm = ctx.Expand(b.Adm)
+}
+
+// Expand substitutes the auction macros in s in a single pass, so values containing macros aren't expanded
+// again. s is returned as is when it holds no macros
+func (ctx AuctionContext) Expand(s string) string {
+	i := strings.Index(s, "${")
+	if i < 0 {
+		return s
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for i >= 0 {
+		end := strings.IndexByte(s[i+2:], '}')
+		if end < 0 {
+			break
+		}
+		end += i + 2
+
+		v, ok := ctx.value(s[i+2 : end])
+		if !ok {
+			// keep the unknown macro and look for the next one after its opening
+			sb.WriteString(s[:i+2])
+			s = s[i+2:]
+		} else {
+			sb.WriteString(s[:i])
+			sb.WriteString(v)
+			s = s[end+1:]
+		}
+		i = strings.Index(s, "${")
+	}
+	sb.WriteString(s)
+	return sb.String()
+}
+
+// value returns the encoded value of the macro, which may carry the :B64 suffix
+func (ctx AuctionContext) value(macro string) (string, bool) {
+	name, b64 := macro, false
+	if strings.HasSuffix(macro, ":B64") {
+		name, b64 = macro[:len(macro)-len(":B64")], true
+	}
+
+	var v string
+	switch name {
+	case MacroAuctionID:
+		v = ctx.AuctionID
+	case MacroAuctionBidID:
+		v = ctx.BidID
+	case MacroAuctionImpID:
+		v = ctx.ImpID
+	case MacroAuctionSeatID:
+		v = ctx.SeatID
+	case MacroAuctionAdID:
+		v = ctx.AdID
+	case MacroAuctionPrice:
+		v = strconv.FormatFloat(ctx.Price, 'f', -1, 64)
+	case MacroAuctionCurrency:
+		v = ctx.Currency
+	case MacroAuctionMBR:
+		v = strconv.FormatFloat(ctx.MBR, 'f', -1, 64)
+	case MacroAuctionLoss:
+		v = strconv.Itoa(int(ctx.Loss))
+	default:
+		return "", false
+	}
+
+	if enc, ok := ctx.Encoders[name]; ok {
+		v = enc(v)
+	}
+	if b64 {
+		v = Base64Encoder(v)
+	}
+	return v, true
+}
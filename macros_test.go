@@ -0,0 +1,99 @@
+package twofive
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExpandMacros(t *testing.T) {
+	ctx := AuctionContext{
+		AuctionID: "auction1",
+		BidID:     "response1",
+		SeatID:    "seat1",
+		Price:     2.51,
+		Currency:  "USD",
+		MBR:       0.5,
+		Loss:      LossLostToHigherBid,
+	}
+
+	tests := []struct {
+		name string
+		ctx  AuctionContext
+		in   string
+		want string
+	}{
+		{
+			name: "All Macros",
+			ctx:  ctx,
+			in:   "https://win.example.com/?a=${AUCTION_ID}&b=${AUCTION_BID_ID}&i=${AUCTION_IMP_ID}&s=${AUCTION_SEAT_ID}&ad=${AUCTION_AD_ID}&p=${AUCTION_PRICE}&c=${AUCTION_CURRENCY}&m=${AUCTION_MBR}&l=${AUCTION_LOSS}",
+			want: "https://win.example.com/?a=auction1&b=response1&i=imp1&s=seat1&ad=ad1&p=2.51&c=USD&m=0.5&l=102",
+		},
+		{
+			name: "No Macros",
+			ctx:  ctx,
+			in:   "https://win.example.com/",
+			want: "https://win.example.com/",
+		},
+		{
+			name: "Unknown Macros",
+			ctx:  ctx,
+			in:   "${CACHEBUSTER}${AUCTION_PRICE}${AUCTION_PRICE${AUCTION_CURRENCY}",
+			want: "${CACHEBUSTER}2.51${AUCTION_PRICEUSD",
+		},
+		{
+			name: "Unterminated",
+			ctx:  ctx,
+			in:   "${AUCTION_ID}&p=${AUCTION_PRICE",
+			want: "auction1&p=${AUCTION_PRICE",
+		},
+		{
+			name: "Base64",
+			ctx:  ctx,
+			in:   "p=${AUCTION_PRICE:B64}",
+			want: "p=Mi41MQ",
+		},
+		{
+			name: "Encoder",
+			ctx: func() AuctionContext {
+				c := ctx
+				c.Encoders = map[string]MacroEncoder{MacroAuctionPrice: strings.ToUpper, MacroAuctionID: func(string) string { return "${AUCTION_ID}" }}
+				return c
+			}(),
+			in:   "p=${AUCTION_PRICE:B64}&a=${AUCTION_ID}",
+			want: "p=Mi41MQ&a=${AUCTION_ID}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Bid{ImpID: "imp1", Adid: "ad1", NURL: tt.in, Adm: "<img src='" + tt.in + "'>"}
+			b.ExpandMacros(tt.ctx)
+			if b.NURL != tt.want {
+				t.Errorf("nurl = %s, want %s", b.NURL, tt.want)
+			}
+			if want := "<img src='" + tt.want + "'>"; b.Adm != want {
+				t.Errorf("adm = %s, want %s", b.Adm, want)
+			}
+		})
+	}
+}
+
+func TestOutcomeAuctionContext(t *testing.T) {
+	resp := &BidResponse{ID: "auction1", BidID: "response1"}
+	o := BidOutcome{ImpID: "imp1", Seat: "seat1", Bid: &Bid{Adid: "ad1", Price: 4}, Response: resp, Price: 3}
+	want := AuctionContext{AuctionID: "auction1", BidID: "response1", ImpID: "imp1", SeatID: "seat1", AdID: "ad1", Price: 3, Currency: "USD", MBR: 0.75}
+	if got := o.AuctionContext("USD"); !reflect.DeepEqual(got, want) {
+		t.Errorf("AuctionContext() = %+v, want %+v", got, want)
+	}
+}
+
+func BenchmarkExpandMacros(b *testing.B) {
+	ctx := AuctionContext{AuctionID: "auction1", Price: 2.51, Currency: "USD"}
+	adm := strings.Repeat("<div>creative</div>", 100) + "<img src='https://win.example.com/?a=${AUCTION_ID}&p=${AUCTION_PRICE}&c=${AUCTION_CURRENCY}'>"
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		bid := Bid{NURL: "https://win.example.com/?p=${AUCTION_PRICE}", Adm: adm}
+		bid.ExpandMacros(ctx)
+	}
+}
@@ -0,0 +1,263 @@
+package twofive
+
+import "strconv"
+
+// The enumerated lists of section 7 of the Dynamic Native Ads API 1.2 spec, following the same pattern as the
+// OpenRTB lists in enums.go
+
+// NativeContextType is the context in which the native ad appears, refer to native list 7.1
+type NativeContextType int
+
+// 7.1 Context Type IDs
+const (
+	NativeContextContent NativeContextType = 1
+	NativeContextSocial  NativeContextType = 2
+	NativeContextProduct NativeContextType = 3
+)
+
+var nativeContextTypeNames = map[NativeContextType]string{
+	NativeContextContent: "Content-centric context",
+	NativeContextSocial:  "Social-centric context",
+	NativeContextProduct: "Product context",
+}
+
+func (n NativeContextType) String() string {
+	if s, ok := nativeContextTypeNames[n]; ok {
+		return s
+	}
+	return "NativeContextType(" + strconv.Itoa(int(n)) + ")"
+}
+
+// IsValid reports if n is one of the values defined by native list 7.1
+func (n NativeContextType) IsValid() bool {
+	_, ok := nativeContextTypeNames[n]
+	return ok
+}
+
+// NativeContextSubType is a more detailed context in which the native ad appears, refer to native list 7.2
+type NativeContextSubType int
+
+// 7.2 Context Sub Type IDs
+const (
+	NativeSubContextGeneral       NativeContextSubType = 10
+	NativeSubContextArticle       NativeContextSubType = 11
+	NativeSubContextVideo         NativeContextSubType = 12
+	NativeSubContextAudio         NativeContextSubType = 13
+	NativeSubContextImage         NativeContextSubType = 14
+	NativeSubContextUserGenerated NativeContextSubType = 15
+	NativeSubContextSocial        NativeContextSubType = 20
+	NativeSubContextEmail         NativeContextSubType = 21
+	NativeSubContextChatIM        NativeContextSubType = 22
+	NativeSubContextSelling       NativeContextSubType = 30
+	NativeSubContextAppStore      NativeContextSubType = 31
+	NativeSubContextProductReview NativeContextSubType = 32
+)
+
+var nativeContextSubTypeNames = map[NativeContextSubType]string{
+	NativeSubContextGeneral:       "General or mixed content",
+	NativeSubContextArticle:       "Primarily article content",
+	NativeSubContextVideo:         "Primarily video content",
+	NativeSubContextAudio:         "Primarily audio content",
+	NativeSubContextImage:         "Primarily image content",
+	NativeSubContextUserGenerated: "User-generated content",
+	NativeSubContextSocial:        "General social content",
+	NativeSubContextEmail:         "Primarily email content",
+	NativeSubContextChatIM:        "Primarily chat/IM content",
+	NativeSubContextSelling:       "Content focused on selling products",
+	NativeSubContextAppStore:      "Application store/marketplace",
+	NativeSubContextProductReview: "Product reviews site primarily",
+}
+
+func (n NativeContextSubType) String() string {
+	if s, ok := nativeContextSubTypeNames[n]; ok {
+		return s
+	}
+	return "NativeContextSubType(" + strconv.Itoa(int(n)) + ")"
+}
+
+// IsValid reports if n is one of the values defined by native list 7.2
+func (n NativeContextSubType) IsValid() bool {
+	_, ok := nativeContextSubTypeNames[n]
+	return ok
+}
+
+// NativePlacementType is the design/format/layout of the native ad unit being offered, refer to native list 7.3
+type NativePlacementType int
+
+// 7.3 Placement Type IDs
+const (
+	NativePlacementInFeed         NativePlacementType = 1
+	NativePlacementAtomic         NativePlacementType = 2
+	NativePlacementOutside        NativePlacementType = 3
+	NativePlacementRecommendation NativePlacementType = 4
+)
+
+var nativePlacementTypeNames = map[NativePlacementType]string{
+	NativePlacementInFeed:         "In the feed of content",
+	NativePlacementAtomic:         "In the atomic unit of the content",
+	NativePlacementOutside:        "Outside the core content",
+	NativePlacementRecommendation: "Recommendation widget",
+}
+
+func (n NativePlacementType) String() string {
+	if s, ok := nativePlacementTypeNames[n]; ok {
+		return s
+	}
+	return "NativePlacementType(" + strconv.Itoa(int(n)) + ")"
+}
+
+// IsValid reports if n is one of the values defined by native list 7.3
+func (n NativePlacementType) IsValid() bool {
+	_, ok := nativePlacementTypeNames[n]
+	return ok
+}
+
+// DataAssetType is the type of a data asset, refer to native list 7.4
+type DataAssetType int
+
+// 7.4 Data Asset Types
+const (
+	DataSponsored        DataAssetType = 1
+	DataDesc             DataAssetType = 2
+	DataRating           DataAssetType = 3
+	DataLikes            DataAssetType = 4
+	DataDownloads        DataAssetType = 5
+	DataPrice            DataAssetType = 6
+	DataSalePrice        DataAssetType = 7
+	DataPhone            DataAssetType = 8
+	DataAddress          DataAssetType = 9
+	DataDesc2            DataAssetType = 10
+	DataDisplayURL       DataAssetType = 11
+	DataCTAText          DataAssetType = 12
+	DataExchangeSpecific DataAssetType = 500 // 500+ are exchange specific
+)
+
+var dataAssetTypeNames = map[DataAssetType]string{
+	DataSponsored:  "sponsored",
+	DataDesc:       "desc",
+	DataRating:     "rating",
+	DataLikes:      "likes",
+	DataDownloads:  "downloads",
+	DataPrice:      "price",
+	DataSalePrice:  "saleprice",
+	DataPhone:      "phone",
+	DataAddress:    "address",
+	DataDesc2:      "desc2",
+	DataDisplayURL: "displayurl",
+	DataCTAText:    "ctatext",
+}
+
+func (d DataAssetType) String() string {
+	if s, ok := dataAssetTypeNames[d]; ok {
+		return s
+	}
+	if d >= DataExchangeSpecific {
+		return "Exchange Specific " + strconv.Itoa(int(d))
+	}
+	return "DataAssetType(" + strconv.Itoa(int(d)) + ")"
+}
+
+// IsValid reports if d is one of the values defined by native list 7.4, exchange specific values are always valid
+func (d DataAssetType) IsValid() bool {
+	_, ok := dataAssetTypeNames[d]
+	return ok || d >= DataExchangeSpecific
+}
+
+// ImageAssetType is the type of an image asset, refer to native list 7.5
+type ImageAssetType int
+
+// 7.5 Image Asset Types
+const (
+	ImageIcon             ImageAssetType = 1
+	ImageLogo             ImageAssetType = 2 // deprecated in 1.2
+	ImageMain             ImageAssetType = 3
+	ImageExchangeSpecific ImageAssetType = 500 // 500+ are exchange specific
+)
+
+var imageAssetTypeNames = map[ImageAssetType]string{
+	ImageIcon: "Icon",
+	ImageLogo: "Logo",
+	ImageMain: "Main",
+}
+
+func (i ImageAssetType) String() string {
+	if s, ok := imageAssetTypeNames[i]; ok {
+		return s
+	}
+	if i >= ImageExchangeSpecific {
+		return "Exchange Specific " + strconv.Itoa(int(i))
+	}
+	return "ImageAssetType(" + strconv.Itoa(int(i)) + ")"
+}
+
+// IsValid reports if i is one of the values defined by native list 7.5, exchange specific values are always valid
+func (i ImageAssetType) IsValid() bool {
+	_, ok := imageAssetTypeNames[i]
+	return ok || i >= ImageExchangeSpecific
+}
+
+// EventType is the type of event a tracker is fired for, refer to native list 7.6
+type EventType int
+
+// 7.6 Event Types
+const (
+	EventImpression       EventType = 1
+	EventViewableMRC50    EventType = 2
+	EventViewableMRC100   EventType = 3
+	EventViewableVideo50  EventType = 4
+	EventExchangeSpecific EventType = 500 // 500+ are exchange specific
+)
+
+var eventTypeNames = map[EventType]string{
+	EventImpression:      "Impression",
+	EventViewableMRC50:   "viewable-mrc50",
+	EventViewableMRC100:  "viewable-mrc100",
+	EventViewableVideo50: "viewable-video50",
+}
+
+func (e EventType) String() string {
+	if s, ok := eventTypeNames[e]; ok {
+		return s
+	}
+	if e >= EventExchangeSpecific {
+		return "Exchange Specific " + strconv.Itoa(int(e))
+	}
+	return "EventType(" + strconv.Itoa(int(e)) + ")"
+}
+
+// IsValid reports if e is one of the values defined by native list 7.6, exchange specific values are always valid
+func (e EventType) IsValid() bool {
+	_, ok := eventTypeNames[e]
+	return ok || e >= EventExchangeSpecific
+}
+
+// EventTrackingMethod is the method an event tracker is fired with, refer to native list 7.7
+type EventTrackingMethod int
+
+// 7.7 Event Tracking Methods
+const (
+	TrackingImage            EventTrackingMethod = 1
+	TrackingJS               EventTrackingMethod = 2
+	TrackingExchangeSpecific EventTrackingMethod = 500 // 500+ are exchange specific
+)
+
+var eventTrackingMethodNames = map[EventTrackingMethod]string{
+	TrackingImage: "img",
+	TrackingJS:    "js",
+}
+
+func (e EventTrackingMethod) String() string {
+	if s, ok := eventTrackingMethodNames[e]; ok {
+		return s
+	}
+	if e >= TrackingExchangeSpecific {
+		return "Exchange Specific " + strconv.Itoa(int(e))
+	}
+	return "EventTrackingMethod(" + strconv.Itoa(int(e)) + ")"
+}
+
+// IsValid reports if e is one of the values defined by native list 7.7, exchange specific values are always valid
+func (e EventTrackingMethod) IsValid() bool {
+	_, ok := eventTrackingMethodNames[e]
+	return ok || e >= TrackingExchangeSpecific
+}
@@ -0,0 +1,167 @@
+package twofive
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// NativeVersion is the version of the Dynamic Native Ads API the native objects model
+const NativeVersion = "1.2"
+
+// ErrNoNative is returned when parsing an empty native request or native ad markup
+var ErrNoNative = errors.New("twofive: no native request or markup")
+
+// NativeRequest Dynamic Native Ads API 1.2 spec, carried encoded as a string in Native.Request
+type NativeRequest struct {
+	Ver            string               `json:"ver,omitempty"            valid:"-"`
+	Context        NativeContextType    `json:"context,omitempty"        valid:"-"`
+	ContextSubType NativeContextSubType `json:"contextsubtype,omitempty" valid:"-"`
+	PlcmtType      NativePlacementType  `json:"plcmttype,omitempty"      valid:"-"`
+	PlcmtCnt       int                  `json:"plcmtcnt,omitempty"       valid:"-"` // defaults to 1
+	Seq            int                  `json:"seq,omitempty"            valid:"-"`
+	Assets         []NativeAsset        `json:"assets"                   valid:"required"`
+	AURLSupport    int                  `json:"aurlsupport,omitempty"    valid:"range(0|1),optional"`
+	DURLSupport    int                  `json:"durlsupport,omitempty"    valid:"range(0|1),optional"`
+	EventTrackers  []NativeEventTracker `json:"eventtrackers,omitempty"  valid:"-"`
+	Privacy        int                  `json:"privacy,omitempty"        valid:"range(0|1),optional"`
+	AdUnit         int                  `json:"adunit,omitempty"         valid:"-"` // 1.0 only, replaced by context and plcmttype
+	Layout         int                  `json:"layout,omitempty"         valid:"-"` // 1.0 only, replaced by context and plcmttype
+	Ext            *NativeRequestExt    `json:"ext,omitempty"            valid:"-"`
+}
+
+// NativeRequestExt ...
+type NativeRequestExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeAsset is a single asset the native ad is made up of, exactly one of title, img, video or data is set
+type NativeAsset struct {
+	ID       int             `json:"id"                 valid:"-"`
+	Required int             `json:"required,omitempty" valid:"range(0|1),optional"`
+	Title    *NativeTitle    `json:"title,omitempty"    valid:"optional"`
+	Img      *NativeImage    `json:"img,omitempty"      valid:"optional"`
+	Video    *NativeVideo    `json:"video,omitempty"    valid:"optional"`
+	Data     *NativeData     `json:"data,omitempty"     valid:"optional"`
+	Ext      *NativeAssetExt `json:"ext,omitempty"      valid:"-"`
+}
+
+// NativeAssetExt ...
+type NativeAssetExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeTitle asks for the title of the native ad
+type NativeTitle struct {
+	Len int             `json:"len"           valid:"required"` // maximum length of the title
+	Ext *NativeTitleExt `json:"ext,omitempty" valid:"-"`
+}
+
+// NativeTitleExt ...
+type NativeTitleExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeImage asks for an image of the native ad, w and h are exact sizes while wmin and hmin are minimums
+type NativeImage struct {
+	Type  ImageAssetType  `json:"type,omitempty"  valid:"-"`
+	W     int             `json:"w,omitempty"     valid:"-"`
+	WMin  int             `json:"wmin,omitempty"  valid:"-"`
+	H     int             `json:"h,omitempty"     valid:"-"`
+	HMin  int             `json:"hmin,omitempty"  valid:"-"`
+	Mimes []string        `json:"mimes,omitempty" valid:"-"`
+	Ext   *NativeImageExt `json:"ext,omitempty"   valid:"-"`
+}
+
+// NativeImageExt ...
+type NativeImageExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeVideo asks for a video of the native ad, answered with a VAST tag
+type NativeVideo struct {
+	Mimes       []string        `json:"mimes"         valid:"required"`
+	MinDuration int             `json:"minduration"   valid:"-"`
+	MaxDuration int             `json:"maxduration"   valid:"-"`
+	Protocols   []Protocol      `json:"protocols"     valid:"required"`
+	Ext         *NativeVideoExt `json:"ext,omitempty" valid:"-"`
+}
+
+// NativeVideoExt ...
+type NativeVideoExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeData asks for a data element of the native ad, such as the rating or the call to action text
+type NativeData struct {
+	Type DataAssetType  `json:"type"          valid:"required"`
+	Len  int            `json:"len,omitempty" valid:"-"` // maximum length of the value
+	Ext  *NativeDataExt `json:"ext,omitempty" valid:"-"`
+}
+
+// NativeDataExt ...
+type NativeDataExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeEventTracker is an event the exchange supports tracking and the methods it can be tracked with
+type NativeEventTracker struct {
+	Event   EventType              `json:"event"         valid:"required"`
+	Methods []EventTrackingMethod  `json:"methods"       valid:"required"`
+	Ext     *NativeEventTrackerExt `json:"ext,omitempty" valid:"-"`
+}
+
+// NativeEventTrackerExt ...
+type NativeEventTrackerExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// nativeWrapper is the 1.0 form, with the native object nested under a native key
+type nativeWrapper struct {
+	Native json.RawMessage `json:"native"`
+}
+
+// SetRequest encodes r into the request of the native impression, legacy nests it under a native key as
+// version 1.0 did. The version of the impression is set to the version of r if it has one
+func (n *Native) SetRequest(r NativeRequest, legacy bool) error {
+	b, err := encodeNative(r, legacy)
+	if err != nil {
+		return err
+	}
+	n.Request = string(b)
+	if r.Ver != "" {
+		n.Ver = r.Ver
+	}
+	return nil
+}
+
+// ParseRequest decodes the request of the native impression, either with or without the 1.0 native wrapper
+func (n Native) ParseRequest() (*NativeRequest, error) {
+	var r NativeRequest
+	if err := decodeNative(n.Request, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func encodeNative(v interface{}, legacy bool) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil || !legacy {
+		return b, err
+	}
+	return json.Marshal(nativeWrapper{Native: b})
+}
+
+func decodeNative(s string, v interface{}) error {
+	if s == "" {
+		return ErrNoNative
+	}
+
+	var w nativeWrapper
+	if err := json.Unmarshal([]byte(s), &w); err != nil {
+		return err
+	}
+	if len(w.Native) > 0 && string(w.Native) != "null" {
+		return json.Unmarshal(w.Native, v)
+	}
+	return json.Unmarshal([]byte(s), v)
+}
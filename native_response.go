@@ -0,0 +1,130 @@
+package twofive
+
+import "encoding/json"
+
+// NativeResponse Dynamic Native Ads API 1.2 spec, carried encoded as a string in the adm of a native bid
+type NativeResponse struct {
+	Ver           string                       `json:"ver,omitempty"`
+	Assets        []NativeResponseAsset        `json:"assets,omitempty"`
+	AssetsURL     string                       `json:"assetsurl,omitempty"`
+	DCOURL        string                       `json:"dcourl,omitempty"`
+	Link          NativeLink                   `json:"link"`
+	ImpTrackers   []string                     `json:"imptrackers,omitempty"` // deprecated in 1.2, use eventtrackers
+	JSTracker     string                       `json:"jstracker,omitempty"`   // deprecated in 1.2, use eventtrackers
+	EventTrackers []NativeEventTrackerResponse `json:"eventtrackers,omitempty"`
+	Privacy       string                       `json:"privacy,omitempty"`
+	Ext           *NativeResponseExt           `json:"ext,omitempty"`
+}
+
+// NativeResponseExt ...
+type NativeResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeLink is the destination of a click on the native ad or one of its assets
+type NativeLink struct {
+	URL           string         `json:"url"`
+	ClickTrackers []string       `json:"clicktrackers,omitempty"`
+	Fallback      string         `json:"fallback,omitempty"`
+	Ext           *NativeLinkExt `json:"ext,omitempty"`
+}
+
+// NativeLinkExt ...
+type NativeLinkExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeResponseAsset answers the asset of the native request with the same id
+type NativeResponseAsset struct {
+	ID       int                     `json:"id"`
+	Required int                     `json:"required,omitempty"`
+	Title    *NativeTitleResponse    `json:"title,omitempty"`
+	Img      *NativeImageResponse    `json:"img,omitempty"`
+	Video    *NativeVideoResponse    `json:"video,omitempty"`
+	Data     *NativeDataResponse     `json:"data,omitempty"`
+	Link     *NativeLink             `json:"link,omitempty"`
+	Ext      *NativeResponseAssetExt `json:"ext,omitempty"`
+}
+
+// NativeResponseAssetExt ...
+type NativeResponseAssetExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeTitleResponse ...
+type NativeTitleResponse struct {
+	Text string                  `json:"text"`
+	Len  int                     `json:"len,omitempty"`
+	Ext  *NativeTitleResponseExt `json:"ext,omitempty"`
+}
+
+// NativeTitleResponseExt ...
+type NativeTitleResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeImageResponse ...
+type NativeImageResponse struct {
+	Type ImageAssetType          `json:"type,omitempty"`
+	URL  string                  `json:"url"`
+	W    int                     `json:"w,omitempty"`
+	H    int                     `json:"h,omitempty"`
+	Ext  *NativeImageResponseExt `json:"ext,omitempty"`
+}
+
+// NativeImageResponseExt ...
+type NativeImageResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeVideoResponse ...
+type NativeVideoResponse struct {
+	VASTTag string `json:"vasttag"`
+}
+
+// NativeDataResponse ...
+type NativeDataResponse struct {
+	Type  DataAssetType          `json:"type,omitempty"`
+	Len   int                    `json:"len,omitempty"`
+	Value string                 `json:"value"`
+	Ext   *NativeDataResponseExt `json:"ext,omitempty"`
+}
+
+// NativeDataResponseExt ...
+type NativeDataResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// NativeEventTrackerResponse is a tracker to fire for an event, url is set for image trackers and is the
+// script URL for js trackers
+type NativeEventTrackerResponse struct {
+	Event      EventType                      `json:"event"`
+	Method     EventTrackingMethod            `json:"method"`
+	URL        string                         `json:"url,omitempty"`
+	CustomData json.RawMessage                `json:"customdata,omitempty"`
+	Ext        *NativeEventTrackerResponseExt `json:"ext,omitempty"`
+}
+
+// NativeEventTrackerResponseExt ...
+type NativeEventTrackerResponseExt struct {
+	Extensions `json:"-" valid:"-"`
+}
+
+// SetNativeAdm encodes r into the markup of the bid, legacy nests it under a native key as version 1.0 did
+func (b *Bid) SetNativeAdm(r NativeResponse, legacy bool) error {
+	adm, err := encodeNative(r, legacy)
+	if err != nil {
+		return err
+	}
+	b.Adm = string(adm)
+	return nil
+}
+
+// ParseNativeAdm decodes the markup of a native bid, either with or without the 1.0 native wrapper
+func (b Bid) ParseNativeAdm() (*NativeResponse, error) {
+	var r NativeResponse
+	if err := decodeNative(b.Adm, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
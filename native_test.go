@@ -0,0 +1,104 @@
+package twofive
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func loadResponse(t testing.TB, file string) *BidResponse {
+	t.Helper()
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r BidResponse
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	return &r
+}
+
+func TestNativeRequest(t *testing.T) {
+	r := loadRequest(t, "./test_data/native_bid_request.json")
+	n, err := r.Imp[0].Native.ParseRequest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Context != NativeContextContent || n.PlcmtType != NativePlacementInFeed || len(n.Assets) != 5 {
+		t.Fatalf("ParseRequest() = %+v", n)
+	}
+	if img := n.Assets[1].Img; img == nil || img.Type != ImageMain || img.WMin != 300 {
+		t.Errorf("assets[1].img = %+v", img)
+	}
+	if e := n.EventTrackers[0]; e.Event != EventImpression || !reflect.DeepEqual(e.Methods, []EventTrackingMethod{TrackingImage, TrackingJS}) {
+		t.Errorf("eventtrackers[0] = %+v", e)
+	}
+
+	for _, legacy := range []bool{false, true} {
+		var native Native
+		if err := native.SetRequest(*n, legacy); err != nil {
+			t.Fatal(err)
+		}
+		if native.Ver != NativeVersion {
+			t.Errorf("SetRequest() ver = %q, want %q", native.Ver, NativeVersion)
+		}
+		var wrapped map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(native.Request), &wrapped); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := wrapped["native"]; ok != legacy {
+			t.Errorf("SetRequest(legacy %v) = %s", legacy, native.Request)
+		}
+
+		got, err := native.ParseRequest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, n) {
+			t.Errorf("ParseRequest(legacy %v) = %+v, want %+v", legacy, got, n)
+		}
+	}
+
+	if _, err := (Native{}).ParseRequest(); err != ErrNoNative {
+		t.Errorf("ParseRequest() of an empty request = %v, want ErrNoNative", err)
+	}
+}
+
+func TestNativeResponse(t *testing.T) {
+	resp := loadResponse(t, "./test_data/native_bid_response.json")
+	bid := &resp.SeatBid[0].Bid[0]
+
+	// the fixture is in the 1.0 wrapped form
+	n, err := bid.ParseNativeAdm()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Link.URL != "https://itunes.apple.com/us/app/foo" || len(n.Assets) != 4 {
+		t.Fatalf("ParseNativeAdm() = %+v", n)
+	}
+	if d := n.Assets[3].Data; d == nil || d.Type != DataCTAText || d.Value != "Install" {
+		t.Errorf("assets[3].data = %+v", d)
+	}
+
+	if err := bid.SetNativeAdm(*n, false); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"ver":"1.2","assets":[{"id":1,"title":{"text":"Foo, the app everyone is talking about"}},` +
+		`{"id":2,"img":{"type":3,"url":"https://cdn.foo.com/main.jpg","w":600,"h":500}},` +
+		`{"id":4,"data":{"type":1,"value":"Foo Inc."}},{"id":5,"data":{"type":12,"value":"Install"}}],` +
+		`"link":{"url":"https://itunes.apple.com/us/app/foo","clicktrackers":["https://track.foo.com/click"]},` +
+		`"eventtrackers":[{"event":1,"method":1,"url":"https://track.foo.com/imp"}],"privacy":"https://foo.com/privacy"}`
+	if bid.Adm != want {
+		t.Errorf("SetNativeAdm() = %s, want %s", bid.Adm, want)
+	}
+
+	got, err := bid.ParseNativeAdm()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, n) {
+		t.Errorf("ParseNativeAdm() = %+v, want %+v", got, n)
+	}
+}
@@ -0,0 +1,181 @@
+package twofive
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// ValidateNative parses the request of the native impression and the markup of the bid answering it, and
+// checks the response against the request with ValidateNativeResponse. A request that can't be parsed is
+// returned as is, markup that can't be parsed fails the "native" rule of the adm. Otherwise the error is
+// always of type ValidationErrors, with paths relative to the native response
+func ValidateNative(native Native, bid Bid) error {
+	req, err := native.ParseRequest()
+	if err != nil {
+		return err
+	}
+	resp, err := bid.ParseNativeAdm()
+	if err != nil {
+		return ValidationErrors{{Path: "adm", Rule: "native", Value: err.Error()}}
+	}
+	return ValidateNativeResponse(req, resp)
+}
+
+// ValidateNativeResponse checks that a native response answers its request: every required asset is answered,
+// each asset is of the type asked for and within the length and size limits asked for, and every event tracker
+// uses an event and method the request supports. A nil error means the response is valid, otherwise the error
+// is always of type ValidationErrors
+func ValidateNativeResponse(req *NativeRequest, resp *NativeResponse) error {
+	var errs ValidationErrors
+	if resp.Link.URL == "" && resp.AssetsURL == "" && resp.DCOURL == "" {
+		errs = append(errs, ValidationError{Path: "link.url", Rule: "required", Value: ""})
+	}
+
+	asked := make(map[int]*NativeAsset, len(req.Assets))
+	for i := range req.Assets {
+		asked[req.Assets[i].ID] = &req.Assets[i]
+	}
+
+	answered := make(map[int]bool, len(resp.Assets))
+	for i := range resp.Assets {
+		a := &resp.Assets[i]
+		path := "assets[" + strconv.Itoa(i) + "]"
+		if answered[a.ID] {
+			errs = append(errs, ValidationError{Path: path + ".id", Rule: "unique", Value: a.ID})
+			continue
+		}
+		answered[a.ID] = true
+
+		ask, ok := asked[a.ID]
+		if !ok {
+			errs = append(errs, ValidationError{Path: path + ".id", Rule: "in(request.assets.id)", Value: a.ID})
+			continue
+		}
+		errs = validateNativeAsset(ask, a, path, errs)
+	}
+
+	// the assets may instead be served from assetsurl or dcourl, which can't be checked here
+	if len(resp.Assets) > 0 || (resp.AssetsURL == "" && resp.DCOURL == "") {
+		for _, a := range req.Assets {
+			if a.Required == 1 && !answered[a.ID] {
+				errs = append(errs, ValidationError{Path: "assets", Rule: "required", Value: a.ID})
+			}
+		}
+	}
+
+	methods := make(map[EventType][]EventTrackingMethod, len(req.EventTrackers))
+	for _, e := range req.EventTrackers {
+		methods[e.Event] = append(methods[e.Event], e.Methods...)
+	}
+	for i, e := range resp.EventTrackers {
+		errs = validateNativeEventTracker(methods, e, "eventtrackers["+strconv.Itoa(i)+"]", errs)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validateNativeAsset checks a single response asset against the request asset of the same id
+func validateNativeAsset(ask *NativeAsset, a *NativeResponseAsset, path string, errs ValidationErrors) ValidationErrors {
+	switch {
+	case ask.Title != nil:
+		if a.Title == nil {
+			return append(errs, ValidationError{Path: path + ".title", Rule: "required"})
+		}
+		if ask.Title.Len > 0 && utf8.RuneCountInString(a.Title.Text) > ask.Title.Len {
+			errs = append(errs, ValidationError{Path: path + ".title.text", Rule: "maxlen(" + strconv.Itoa(ask.Title.Len) + ")", Value: a.Title.Text})
+		}
+
+	case ask.Img != nil:
+		if a.Img == nil {
+			return append(errs, ValidationError{Path: path + ".img", Rule: "required"})
+		}
+		if a.Img.URL == "" {
+			errs = append(errs, ValidationError{Path: path + ".img.url", Rule: "required", Value: ""})
+		}
+		if ask.Img.Type != 0 && a.Img.Type != 0 && a.Img.Type != ask.Img.Type {
+			errs = append(errs, ValidationError{Path: path + ".img.type", Rule: "eq(" + strconv.Itoa(int(ask.Img.Type)) + ")", Value: a.Img.Type})
+		}
+		errs = validateNativeSize(ask.Img.W, ask.Img.WMin, a.Img.W, path+".img.w", errs)
+		errs = validateNativeSize(ask.Img.H, ask.Img.HMin, a.Img.H, path+".img.h", errs)
+
+	case ask.Video != nil:
+		if a.Video == nil {
+			return append(errs, ValidationError{Path: path + ".video", Rule: "required"})
+		}
+		if a.Video.VASTTag == "" {
+			errs = append(errs, ValidationError{Path: path + ".video.vasttag", Rule: "required", Value: ""})
+		}
+
+	case ask.Data != nil:
+		if a.Data == nil {
+			return append(errs, ValidationError{Path: path + ".data", Rule: "required"})
+		}
+		if a.Data.Type != 0 && a.Data.Type != ask.Data.Type {
+			errs = append(errs, ValidationError{Path: path + ".data.type", Rule: "eq(" + strconv.Itoa(int(ask.Data.Type)) + ")", Value: a.Data.Type})
+		}
+		if ask.Data.Len > 0 && utf8.RuneCountInString(a.Data.Value) > ask.Data.Len {
+			errs = append(errs, ValidationError{Path: path + ".data.value", Rule: "maxlen(" + strconv.Itoa(ask.Data.Len) + ")", Value: a.Data.Value})
+		}
+	}
+	return errs
+}
+
+// validateNativeSize checks an image dimension, a minimum takes precedence over an exact size as the spec
+// recommends. Responses are only recommended to state their size, so an unstated size isn't checked
+func validateNativeSize(exact, min, got int, path string, errs ValidationErrors) ValidationErrors {
+	switch {
+	case got == 0:
+	case min > 0:
+		if got < min {
+			errs = append(errs, ValidationError{Path: path, Rule: "gte(" + strconv.Itoa(min) + ")", Value: got})
+		}
+	case exact > 0:
+		if got != exact {
+			errs = append(errs, ValidationError{Path: path, Rule: "eq(" + strconv.Itoa(exact) + ")", Value: got})
+		}
+	}
+	return errs
+}
+
+// validateNativeEventTracker checks a response tracker against the events and methods the request supports,
+// when the request lists none any defined event and method is accepted
+func validateNativeEventTracker(methods map[EventType][]EventTrackingMethod, e NativeEventTrackerResponse, path string, errs ValidationErrors) ValidationErrors {
+	if e.Method == TrackingImage && e.URL == "" {
+		errs = append(errs, ValidationError{Path: path + ".url", Rule: "required", Value: ""})
+	}
+
+	if len(methods) == 0 {
+		if !e.Event.IsValid() {
+			errs = append(errs, ValidationError{Path: path + ".event", Rule: "in(eventtypes)", Value: e.Event})
+		}
+		if !e.Method.IsValid() {
+			errs = append(errs, ValidationError{Path: path + ".method", Rule: "in(eventtrackingmethods)", Value: e.Method})
+		}
+		return errs
+	}
+
+	supported, ok := methods[e.Event]
+	if !ok {
+		return append(errs, ValidationError{Path: path + ".event", Rule: "in(request.eventtrackers.event)", Value: e.Event})
+	}
+	for _, m := range supported {
+		if m == e.Method {
+			return errs
+		}
+	}
+	return append(errs, ValidationError{Path: path + ".method", Rule: "in(" + joinMethods(supported) + ")", Value: e.Method})
+}
+
+func joinMethods(methods []EventTrackingMethod) string {
+	var s string
+	for i, m := range methods {
+		if i > 0 {
+			s += "|"
+		}
+		s += strconv.Itoa(int(m))
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package twofive
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateNative(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(r *NativeResponse)
+		want   ValidationErrors
+	}{
+		{
+			name: "Valid",
+		},
+		{
+			name:   "Missing Link",
+			mutate: func(r *NativeResponse) { r.Link.URL = "" },
+			want:   ValidationErrors{{Path: "link.url", Rule: "required", Value: ""}},
+		},
+		{
+			name:   "Unknown Asset",
+			mutate: func(r *NativeResponse) { r.Assets[3].ID = 9 },
+			want:   ValidationErrors{{Path: "assets[3].id", Rule: "in(request.assets.id)", Value: 9}},
+		},
+		{
+			name:   "Missing Required Asset",
+			mutate: func(r *NativeResponse) { r.Assets = r.Assets[:2] },
+			want:   ValidationErrors{{Path: "assets", Rule: "required", Value: 4}},
+		},
+		{
+			name:   "Assets From URL",
+			mutate: func(r *NativeResponse) { r.Assets, r.AssetsURL = nil, "https://cdn.foo.com/assets.json" },
+		},
+		{
+			name: "Wrong Asset Type",
+			mutate: func(r *NativeResponse) {
+				r.Assets[0].Img, r.Assets[0].Title = &NativeImageResponse{URL: "https://cdn.foo.com/title.png"}, nil
+			},
+			want: ValidationErrors{{Path: "assets[0].title", Rule: "required"}},
+		},
+		{
+			name:   "Title Too Long",
+			mutate: func(r *NativeResponse) { r.Assets[0].Title.Text = string(make([]rune, 91)) },
+			want:   ValidationErrors{{Path: "assets[0].title.text", Rule: "maxlen(90)", Value: string(make([]rune, 91))}},
+		},
+		{
+			name: "Image Too Small",
+			mutate: func(r *NativeResponse) {
+				r.Assets[1].Img.W = 200
+				r.Assets[1].Img.Type = ImageIcon
+			},
+			want: ValidationErrors{
+				{Path: "assets[1].img.type", Rule: "eq(3)", Value: ImageIcon},
+				{Path: "assets[1].img.w", Rule: "gte(300)", Value: 200},
+			},
+		},
+		{
+			name: "Exact Image Size",
+			mutate: func(r *NativeResponse) {
+				r.Assets = append(r.Assets, NativeResponseAsset{ID: 3, Img: &NativeImageResponse{URL: "https://cdn.foo.com/icon.png", W: 50, H: 60}})
+			},
+			want: ValidationErrors{{Path: "assets[4].img.h", Rule: "eq(50)", Value: 60}},
+		},
+		{
+			name:   "Wrong Data Type",
+			mutate: func(r *NativeResponse) { r.Assets[2].Data.Type = DataDesc },
+			want:   ValidationErrors{{Path: "assets[2].data.type", Rule: "eq(1)", Value: DataDesc}},
+		},
+		{
+			name:   "Data Too Long",
+			mutate: func(r *NativeResponse) { r.Assets[3].Data.Value = "Install the app right now" },
+			want:   ValidationErrors{{Path: "assets[3].data.value", Rule: "maxlen(15)", Value: "Install the app right now"}},
+		},
+		{
+			name:   "Duplicate Asset",
+			mutate: func(r *NativeResponse) { r.Assets[3].ID = 4 },
+			want:   ValidationErrors{{Path: "assets[3].id", Rule: "unique", Value: 4}},
+		},
+		{
+			name: "Unsupported Tracker",
+			mutate: func(r *NativeResponse) {
+				r.EventTrackers = append(r.EventTrackers,
+					NativeEventTrackerResponse{Event: EventViewableMRC50, Method: TrackingJS, URL: "https://track.foo.com/omid.js"},
+					NativeEventTrackerResponse{Event: EventViewableVideo50, Method: TrackingImage})
+			},
+			want: ValidationErrors{
+				{Path: "eventtrackers[1].method", Rule: "in(1)", Value: TrackingJS},
+				{Path: "eventtrackers[2].url", Rule: "required", Value: ""},
+				{Path: "eventtrackers[2].event", Rule: "in(request.eventtrackers.event)", Value: EventViewableVideo50},
+			},
+		},
+	}
+
+	req := loadRequest(t, "./test_data/native_bid_request.json")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid := loadResponse(t, "./test_data/native_bid_response.json").SeatBid[0].Bid[0]
+			if tt.mutate != nil {
+				r, err := bid.ParseNativeAdm()
+				if err != nil {
+					t.Fatal(err)
+				}
+				tt.mutate(r)
+				if err := bid.SetNativeAdm(*r, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := ValidateNative(*req.Imp[0].Native, bid)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("ValidateNative() = %v, want nil", err)
+				}
+				return
+			}
+			got, ok := err.(ValidationErrors)
+			if !ok {
+				t.Fatalf("ValidateNative() = %T %v, want ValidationErrors", err, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateNative() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateResponseNative(t *testing.T) {
+	req := loadRequest(t, "./test_data/native_bid_request.json")
+	resp := loadResponse(t, "./test_data/native_bid_response.json")
+	if err := ValidateResponse(req, resp); err != nil {
+		t.Fatalf("ValidateResponse() = %v, want nil", err)
+	}
+
+	resp.SeatBid[0].Bid[0].Adm = `{"native":{"assets":[`
+	err := ValidateResponse(req, resp)
+	rejections, ok := err.(BidRejections)
+	if !ok || len(rejections) != 1 {
+		t.Fatalf("ValidateResponse() = %v, want one rejection", err)
+	}
+	if r := rejections[0]; r.Path != "seatbid[0].bid[0].adm" || r.Reason != LossCreativeIncorrectFormat {
+		t.Errorf("ValidateResponse() = %+v, want the adm rejected as an incorrect format", r)
+	}
+}
@@ -0,0 +1,19 @@
+package twofive
+
+// The Nimbus keys of imp.ext, registered so that they decode into their types. Read them with GetExt, or
+// TypedExt as a pointer to their type, and set them with SetExt
+const (
+	ImpExtAPS           = "aps"             // []APS
+	ImpExtGoogleID      = "google_id"       // string, a tempary condition (experiment) to determine if google is participating in the Nimbus auction
+	ImpExtFacebookAppID = "facebook_app_id" // string, needed for pubs that have FB hybrid SDK solution in thier stack
+	ImpExtPosition      = "position"        // string, flexible optional field for publishers to track on ad position performance
+	ImpExtViewability   = "viewability"     // int, for demand
+)
+
+func init() {
+	RegisterExt[*Imp](ImpExtAPS, []APS{})
+	RegisterExt[*Imp](ImpExtGoogleID, "")
+	RegisterExt[*Imp](ImpExtFacebookAppID, "")
+	RegisterExt[*Imp](ImpExtPosition, "")
+	RegisterExt[*Imp](ImpExtViewability, 0)
+}
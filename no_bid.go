@@ -0,0 +1,43 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// StatusNoBid is the HTTP status the spec recommends a bidder answers with when it doesn't bid
+const StatusNoBid = http.StatusNoContent
+
+// NoBid returns the response a bidder passes on the request with, giving the reason it didn't bid
+func NoBid(reqID string, reason NoBidReason) BidResponse {
+	return BidResponse{ID: reqID, SeatBid: []Seatbid{}, NBR: reason}
+}
+
+// IsNoBid reports if the response holds no bids, the way a no bid is signalled within a response body
+func (b BidResponse) IsNoBid() bool {
+	for _, sb := range b.SeatBid {
+		if len(sb.Bid) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNoBidResponse reports if the status and body of an HTTP response from a bidder are a no bid, any of the
+// signals of section 7.1 of the spec: a 204, an empty JSON object or a response holding no bids. An empty
+// body, or one that isn't a bid response, isn't a no bid, it's an error
+func IsNoBidResponse(status int, body []byte) bool {
+	if status == StatusNoBid {
+		return true
+	}
+	if status != http.StatusOK || len(bytes.TrimSpace(body)) == 0 {
+		return false
+	}
+
+	var resp BidResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+	return resp.IsNoBid()
+}
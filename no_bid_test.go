@@ -0,0 +1,51 @@
+package twofive
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestNoBid(t *testing.T) {
+	resp := NoBid("auction1", NoBidUnmatchedUser)
+	if !resp.IsNoBid() {
+		t.Error("IsNoBid() = false, want true")
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got BidResponse
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != "auction1" || got.NBR != NoBidUnmatchedUser || !got.IsNoBid() {
+		t.Errorf("round trip of %s = %+v", b, got)
+	}
+}
+
+func TestIsNoBidResponse(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{name: "No Content", status: http.StatusNoContent, want: true},
+		{name: "Empty Body", status: http.StatusOK, body: " \n"},
+		{name: "Empty Object", status: http.StatusOK, body: `{}`, want: true},
+		{name: "Empty Seat Bid", status: http.StatusOK, body: `{"id":"1","seatbid":[{"seat":"a","bid":[]}],"nbr":8}`, want: true},
+		{name: "Bid", status: http.StatusOK, body: `{"id":"1","seatbid":[{"bid":[{"id":"1","impid":"1","price":1}]}]}`},
+		{name: "Bad JSON", status: http.StatusOK, body: `<html>`},
+		{name: "Bad Status", status: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNoBidResponse(tt.status, []byte(tt.body)); got != tt.want {
+				t.Errorf("IsNoBidResponse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
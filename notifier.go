@@ -0,0 +1,300 @@
+package twofive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Notifier defaults, used when the corresponding field of the Notifier is zero
+const (
+	DefaultNotifyConcurrency = 16
+	DefaultNotifyTimeout     = 5 * time.Second
+	DefaultNotifyBackoff     = 100 * time.Millisecond
+	DefaultNotifyDedupSize   = 10000
+)
+
+// NoticeKind is the kind of notice a URL of a bid is fired for
+type NoticeKind int
+
+// The notices of a bid, fired to its nurl, burl and lurl respectively
+const (
+	NoticeWin NoticeKind = iota + 1
+	NoticeBilling
+	NoticeLoss
+)
+
+func (k NoticeKind) String() string {
+	switch k {
+	case NoticeWin:
+		return "win"
+	case NoticeBilling:
+		return "billing"
+	case NoticeLoss:
+		return "loss"
+	}
+	return "NoticeKind(" + strconv.Itoa(int(k)) + ")"
+}
+
+// Notice is a single notice of a bid, URL has had its macros expanded
+type Notice struct {
+	Kind    NoticeKind
+	URL     string
+	Outcome BidOutcome
+}
+
+// NoticeError is a notice that couldn't be delivered, Err is the error of the last attempt
+type NoticeError struct {
+	Notice
+	Err error
+}
+
+func (e NoticeError) Error() string {
+	return fmt.Sprintf("%s notice of bid %s: %v", e.Kind, e.Outcome.Bid.ID, e.Err)
+}
+
+// NoticeErrors is every notice of a call that couldn't be delivered
+type NoticeErrors []NoticeError
+
+func (n NoticeErrors) Error() string {
+	s := make([]string, len(n))
+	for i, e := range n {
+		s[i] = e.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// Notifier fires the win and loss notices of auction results, and the billing notices of the winners once their
+// impression is rendered. Macros are expanded before firing, notices are fired with a bounded concurrency, and
+// are retried on network errors and 5xx responses. A notice already fired for the same bid, of the same
+// response and impression, is skipped, so a result can be safely notified again. A Notifier must not be copied after first use
+type Notifier struct {
+	Transport   http.RoundTripper       // http.DefaultTransport if nil
+	Concurrency int                     // maximum notices in flight across calls
+	Retries     int                     // attempts after the first that fail
+	Timeout     time.Duration           // of each attempt
+	Backoff     time.Duration           // wait before a retry, multiplied by the attempt
+	DedupSize   int                     // how many fired notices are remembered to skip duplicates
+	Encoders    map[string]MacroEncoder // applied when expanding the macros of every notice
+	UserAgent   string                  // sent with every notice if set
+	OnError     func(err NoticeError)   // called, concurrently, for every notice that fails or is dropped, optional
+	once        sync.Once
+	client      *http.Client
+	sem         chan struct{}
+	mu          sync.Mutex
+	fired       map[string]int // fired keys and their index in ring
+	ring        []string       // fired keys in the order they were fired, bounding fired
+	next        int
+}
+
+func (n *Notifier) init() {
+	n.once.Do(func() {
+		n.client = &http.Client{Transport: n.Transport}
+		concurrency := n.Concurrency
+		if concurrency <= 0 {
+			concurrency = DefaultNotifyConcurrency
+		}
+		n.sem = make(chan struct{}, concurrency)
+		size := n.DedupSize
+		if size <= 0 {
+			size = DefaultNotifyDedupSize
+		}
+		n.fired = make(map[string]int, size)
+		n.ring = make([]string, size)
+	})
+}
+
+// Notify fires the win notice of every winner and the loss notice of every loser of the result, waiting for
+// them to be delivered. The error is nil or of type NoticeErrors
+func (n *Notifier) Notify(ctx context.Context, res *AuctionResult) error {
+	var notices []Notice
+	for _, w := range res.Winners {
+		notices = n.appendNotice(notices, NoticeWin, w, res.Currency)
+	}
+	for _, l := range res.Losers {
+		notices = n.appendNotice(notices, NoticeLoss, l, res.Currency)
+	}
+	return n.fire(ctx, notices)
+}
+
+// Bill fires the billing notice of a winner, once its impression is rendered. The error is nil or of type
+// NoticeErrors
+func (n *Notifier) Bill(ctx context.Context, winner BidOutcome, cur string) error {
+	return n.fire(ctx, n.appendNotice(nil, NoticeBilling, winner, cur))
+}
+
+// appendNotice appends the notice of the kind for the outcome, if the bid has a URL for it
+func (n *Notifier) appendNotice(notices []Notice, kind NoticeKind, o BidOutcome, cur string) []Notice {
+	if o.Bid == nil {
+		return notices
+	}
+
+	ctx := o.AuctionContext(cur)
+	ctx.Encoders = n.Encoders
+
+	var url string
+	switch kind {
+	case NoticeWin:
+		url = o.Bid.NURL
+	case NoticeBilling:
+		url = o.Bid.BURL
+	case NoticeLoss:
+		url = o.Bid.LURL
+	}
+	if url == "" {
+		return notices
+	}
+	return append(notices, Notice{Kind: kind, URL: ctx.Expand(url), Outcome: o})
+}
+
+func (n *Notifier) fire(ctx context.Context, notices []Notice) error {
+	n.init()
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs NoticeErrors
+	)
+	for _, notice := range notices {
+		if !n.first(notice) {
+			continue
+		}
+
+		select {
+		case n.sem <- struct{}{}:
+		case <-ctx.Done():
+			n.forget(notice)
+			e := NoticeError{Notice: notice, Err: ctx.Err()}
+			if n.OnError != nil {
+				n.OnError(e)
+			}
+			mu.Lock()
+			errs = append(errs, e)
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(notice Notice) {
+			defer func() {
+				<-n.sem
+				wg.Done()
+			}()
+
+			if err := n.send(ctx, notice); err != nil {
+				n.forget(notice)
+				e := NoticeError{Notice: notice, Err: err}
+				if n.OnError != nil {
+					n.OnError(e)
+				}
+				mu.Lock()
+				errs = append(errs, e)
+				mu.Unlock()
+			}
+		}(notice)
+	}
+	wg.Wait()
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// noticeKey identifies a notice by its kind and bid, the response and impression of which tell the auctions
+// apart, as URLs without macros are the same in every auction. The URL only tells apart bids with the same ids
+func noticeKey(notice Notice) string {
+	o := notice.Outcome
+	var resp, bid string
+	if o.Response != nil {
+		resp = o.Response.ID
+	}
+	if o.Bid != nil {
+		bid = o.Bid.ID
+	}
+	return strings.Join([]string{notice.Kind.String(), resp, bid, o.ImpID, notice.URL}, "\x00")
+}
+
+// first records the notice as fired, reporting false if it already was
+func (n *Notifier) first(notice Notice) bool {
+	key := noticeKey(notice)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.fired[key]; ok {
+		return false
+	}
+	if old := n.ring[n.next]; old != "" && n.fired[old] == n.next {
+		delete(n.fired, old)
+	}
+	n.ring[n.next], n.fired[key] = key, n.next
+	n.next = (n.next + 1) % len(n.ring)
+	return true
+}
+
+// forget removes a notice that failed from the fired notices, so it can be fired again. Its slot in the ring is
+// left to be reused in turn
+func (n *Notifier) forget(notice Notice) {
+	n.mu.Lock()
+	delete(n.fired, noticeKey(notice))
+	n.mu.Unlock()
+}
+
+// send delivers the notice, retrying failed attempts
+func (n *Notifier) send(ctx context.Context, notice Notice) error {
+	timeout := n.Timeout
+	if timeout <= 0 {
+		timeout = DefaultNotifyTimeout
+	}
+	backoff := n.Backoff
+	if backoff <= 0 {
+		backoff = DefaultNotifyBackoff
+	}
+
+	var err error
+	for attempt := 0; attempt <= n.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(time.Duration(attempt) * backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		var retry bool
+		if retry, err = n.attempt(ctx, notice.URL, timeout); err == nil || !retry {
+			return err
+		}
+	}
+	return err
+}
+
+// attempt makes a single request to the url, reporting if a failure is worth retrying
+func (n *Notifier) attempt(ctx context.Context, url string, timeout time.Duration) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false, err
+	}
+	if n.UserAgent != "" {
+		req.Header.Set("User-Agent", n.UserAgent)
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	return resp.StatusCode >= 500, fmt.Errorf("twofive: notice returned %s", resp.Status)
+}
@@ -0,0 +1,163 @@
+package twofive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNotifier(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []string
+		attempts = make(map[string]int)
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts[r.URL.Path]++
+		switch {
+		case r.URL.Path == "/flaky" && attempts[r.URL.Path] == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		case r.URL.Path == "/gone":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		received = append(received, r.URL.RequestURI())
+	}))
+	defer srv.Close()
+
+	resp := &BidResponse{ID: "auction1"}
+	res := &AuctionResult{
+		Currency: "USD",
+		Winners: []BidOutcome{{
+			ImpID: "imp1", Seat: "seat1", Response: resp, Price: 2.51,
+			Bid: &Bid{ID: "bid1", Price: 3, NURL: srv.URL + "/win?p=${AUCTION_PRICE}", BURL: srv.URL + "/bill?p=${AUCTION_PRICE:B64}"},
+		}},
+		Losers: []BidOutcome{
+			{ImpID: "imp1", Seat: "seat2", Response: resp, Price: 2.5, Reason: LossLostToHigherBid, Bid: &Bid{ID: "bid2", LURL: srv.URL + "/loss?r=${AUCTION_LOSS}&a=${AUCTION_ID}"}},
+			{ImpID: "imp1", Seat: "seat3", Response: resp, Price: 1, Reason: LossBelowAuctionFloor, Bid: &Bid{ID: "bid3", LURL: srv.URL + "/flaky"}},
+			{ImpID: "imp1", Seat: "seat4", Response: resp, Price: 1, Reason: LossBelowAuctionFloor, Bid: &Bid{ID: "bid4", LURL: srv.URL + "/gone"}},
+			{ImpID: "imp1", Seat: "seat5", Response: resp, Price: 1, Reason: LossBelowAuctionFloor, Bid: &Bid{ID: "bid5"}},
+		},
+	}
+
+	var onError []NoticeError
+	n := &Notifier{
+		Transport:   srv.Client().Transport,
+		Concurrency: 2,
+		Retries:     2,
+		Backoff:     time.Millisecond,
+		OnError: func(err NoticeError) {
+			mu.Lock()
+			onError = append(onError, err)
+			mu.Unlock()
+		},
+	}
+
+	err := n.Notify(context.Background(), res)
+	errs, ok := err.(NoticeErrors)
+	if !ok || len(errs) != 1 || errs[0].Outcome.Bid.ID != "bid4" || errs[0].Kind != NoticeLoss {
+		t.Fatalf("Notify() = %v, want the loss notice of bid4 to fail", err)
+	}
+	if len(onError) != 1 {
+		t.Errorf("OnError called %d times, want 1", len(onError))
+	}
+
+	// notifying again only retries the failed notice
+	if err := n.Notify(context.Background(), res); err == nil {
+		t.Error("Notify() = nil, want the loss notice of bid4 to fail again")
+	}
+	if err := n.Bill(context.Background(), res.Winners[0], res.Currency); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(received)
+	want := []string{"/bill?p=Mi41MQ", "/flaky", "/loss?r=102&a=auction1", "/win?p=2.51"}
+	if !reflect.DeepEqual(received, want) {
+		t.Errorf("received %v, want %v", received, want)
+	}
+	if attempts["/flaky"] != 2 || attempts["/gone"] != 2 {
+		t.Errorf("attempts = %v, want /flaky retried once and /gone not retried", attempts)
+	}
+}
+
+func TestNotifierAuctions(t *testing.T) {
+	var mu sync.Mutex
+	wins := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		wins++
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	n := &Notifier{Transport: srv.Client().Transport}
+	for _, id := range []string{"auction1", "auction2"} {
+		res := &AuctionResult{Winners: []BidOutcome{{
+			ImpID: "imp1", Response: &BidResponse{ID: id}, Bid: &Bid{ID: "bid1", NURL: srv.URL + "/win"},
+		}}}
+		if err := n.Notify(context.Background(), res); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if wins != 2 {
+		t.Errorf("%d win notices fired, want one per auction", wins)
+	}
+}
+
+func TestNotifierCanceled(t *testing.T) {
+	var onError []NoticeError
+	var mu sync.Mutex
+	n := &Notifier{
+		Transport:   roundTripperFunc(func(*http.Request) (*http.Response, error) { return nil, context.Canceled }),
+		Concurrency: 1,
+		OnError: func(err NoticeError) {
+			mu.Lock()
+			onError = append(onError, err)
+			mu.Unlock()
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res := &AuctionResult{Winners: []BidOutcome{
+		{ImpID: "imp1", Bid: &Bid{ID: "bid1", NURL: "http://example.com/win"}},
+		{ImpID: "imp2", Bid: &Bid{ID: "bid2", NURL: "http://example.com/win"}},
+	}}
+	err := n.Notify(ctx, res)
+	if errs, ok := err.(NoticeErrors); !ok || len(errs) != 2 {
+		t.Fatalf("Notify() = %v, want both notices dropped", err)
+	}
+	if len(onError) != 2 {
+		t.Errorf("OnError called %d times, want 2", len(onError))
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestNotifierDedupSize(t *testing.T) {
+	n := &Notifier{DedupSize: 2}
+	n.init()
+	notice := func(url string) Notice { return Notice{Kind: NoticeWin, URL: url} }
+
+	for _, url := range []string{"a", "b", "c"} {
+		if !n.first(notice(url)) {
+			t.Errorf("first(%s) = false, want true", url)
+		}
+	}
+	if n.first(notice("c")) {
+		t.Error("first(c) = true, want the duplicate skipped")
+	}
+	if !n.first(notice("a")) {
+		t.Error("first(a) = false, want a forgotten once the ring wrapped")
+	}
+}
@@ -0,0 +1,82 @@
+package twofive
+
+import "sync"
+
+// The Reset methods of the objects and the pools they return memory into are generated into reset_gen.go. The
+// decoder allocates the objects and slices it needs from the pools, so decoding into a request that was released
+// and acquired again doesn't allocate them anew
+
+var (
+	requestPool     = sync.Pool{New: func() interface{} { return new(Request) }}
+	bidResponsePool = sync.Pool{New: func() interface{} { return new(BidResponse) }}
+)
+
+// AcquireRequest returns an empty Request from a pool, to be returned with ReleaseRequest once it's done with
+func AcquireRequest() *Request {
+	return requestPool.Get().(*Request)
+}
+
+// ReleaseRequest resets the request and returns it to the pool. Neither the request nor anything it points to
+// may be used after, so it must not share objects or slices with anything still in use
+func ReleaseRequest(req *Request) {
+	if req == nil {
+		return
+	}
+	req.Reset()
+	requestPool.Put(req)
+}
+
+// AcquireBidResponse returns an empty BidResponse from a pool, to be returned with ReleaseBidResponse once it's
+// done with
+func AcquireBidResponse() *BidResponse {
+	return bidResponsePool.Get().(*BidResponse)
+}
+
+// ReleaseBidResponse resets the response and returns it to the pool. Neither the response nor anything it points
+// to may be used after, so a response built by hand must not share objects or slices with anything still in use
+func ReleaseBidResponse(resp *BidResponse) {
+	if resp == nil {
+		return
+	}
+	resp.Reset()
+	bidResponsePool.Put(resp)
+}
+
+// slicePool pools the backing arrays of slices of T. The arrays are held by pointers to slices, which are pooled
+// too, so that neither getting nor putting allocates once the pools are warm
+type slicePool[T any] struct {
+	slices  sync.Pool // pointers to slices holding an array
+	holders sync.Pool // pointers to nil slices
+}
+
+// get returns an empty slice with a pooled array, or nil if there's none
+func (p *slicePool[T]) get() []T {
+	h, _ := p.slices.Get().(*[]T)
+	if h == nil {
+		return nil
+	}
+	s := *h
+	*h = nil
+	p.holders.Put(h)
+	return s
+}
+
+// put zeroes the elements of s up to its capacity, so the decoder growing into them finds them as new, and pools
+// its array
+func (p *slicePool[T]) put(s []T) {
+	if cap(s) == 0 {
+		return
+	}
+	s = s[:cap(s)]
+	var zero T
+	for i := range s {
+		s[i] = zero
+	}
+
+	h, _ := p.holders.Get().(*[]T)
+	if h == nil {
+		h = new([]T)
+	}
+	*h = s[:0]
+	p.slices.Put(h)
+}
@@ -0,0 +1,123 @@
+package twofive
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+// fillAndReset returns a filled T after a Reset, and the zero T it should equal
+func fillAndReset[T any, P interface {
+	*T
+	Reset()
+}]() (interface{}, interface{}) {
+	x := P(new(T))
+	fill(reflect.ValueOf(x).Elem(), 0)
+	x.Reset()
+	return x, new(T)
+}
+
+func TestReset(t *testing.T) {
+	tests := []struct {
+		name  string
+		reset func() (interface{}, interface{})
+	}{
+		{name: "Request", reset: fillAndReset[Request]},
+		{name: "BidResponse", reset: fillAndReset[BidResponse]},
+		{name: "NativeRequest", reset: fillAndReset[NativeRequest]},
+		{name: "NativeResponse", reset: fillAndReset[NativeResponse]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, want := tt.reset()
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %+v, want the zero value", got)
+			}
+		})
+	}
+}
+
+func TestPool(t *testing.T) {
+	// each file is decoded into a value released after decoding the one before, so the objects and slices
+	// reused are ones that held other data
+	requests := []string{
+		"./test_data/video_bid_request.json",
+		"./test_data/static_bid_request.json",
+		"./test_data/native_bid_request.json",
+		"./test_data/site_bid_request.json",
+		"./test_data/video_bid_request.json",
+		"./test_data/static_bid_request.json",
+	}
+	for _, file := range requests {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req := AcquireRequest()
+		if err := req.UnmarshalJSON(data); err != nil {
+			t.Fatal(err)
+		}
+		if want := loadRequest(t, file); !reflect.DeepEqual(req, want) {
+			t.Errorf("%s: pooled request differs\n got %+v\nwant %+v", file, req, want)
+		}
+		ReleaseRequest(req)
+	}
+
+	// a filled response returns slices longer than those of the fixture into the pools
+	filled := AcquireBidResponse()
+	fill(reflect.ValueOf(filled).Elem(), 0)
+	ReleaseBidResponse(filled)
+
+	for _, file := range []string{"./test_data/native_bid_response.json", "./test_data/native_bid_response.json"} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := AcquireBidResponse()
+		if err := json.Unmarshal(data, resp); err != nil {
+			t.Fatal(err)
+		}
+		if want := loadResponse(t, file); !reflect.DeepEqual(resp, want) {
+			t.Errorf("%s: pooled response differs\n got %+v\nwant %+v", file, resp, want)
+		}
+		ReleaseBidResponse(resp)
+	}
+
+	ReleaseRequest(nil)
+	ReleaseBidResponse(nil)
+}
+
+// BenchmarkPool compares decoding into new requests with decoding into pooled ones, which once the pools are warm
+// only allocate the strings decoded
+func BenchmarkPool(b *testing.B) {
+	for _, file := range []string{"./test_data/static_bid_request.json", "./test_data/video_bid_request.json"} {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(file[12:]+"/new", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				var req Request
+				if err := req.UnmarshalJSON(data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(file[12:]+"/pooled", func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				req := AcquireRequest()
+				if err := req.UnmarshalJSON(data); err != nil {
+					b.Fatal(err)
+				}
+				ReleaseRequest(req)
+			}
+		})
+	}
+}
@@ -0,0 +1,929 @@
+// Code generated by internal/gen; DO NOT EDIT.
+
+package twofive
+
+import "sync"
+
+// the pools of the objects pointed to and the backing arrays of slices, reused by the decoder
+var (
+	poolApp                             = sync.Pool{New: func() interface{} { return new(App) }}
+	poolAppExt                          = sync.Pool{New: func() interface{} { return new(AppExt) }}
+	poolAudio                           = sync.Pool{New: func() interface{} { return new(Audio) }}
+	poolAudioExt                        = sync.Pool{New: func() interface{} { return new(AudioExt) }}
+	poolBanner                          = sync.Pool{New: func() interface{} { return new(Banner) }}
+	poolBannerExt                       = sync.Pool{New: func() interface{} { return new(BannerExt) }}
+	poolContent                         = sync.Pool{New: func() interface{} { return new(Content) }}
+	poolContentExt                      = sync.Pool{New: func() interface{} { return new(ContentExt) }}
+	poolDataExt                         = sync.Pool{New: func() interface{} { return new(DataExt) }}
+	poolDealExt                         = sync.Pool{New: func() interface{} { return new(DealExt) }}
+	poolDeviceExt                       = sync.Pool{New: func() interface{} { return new(DeviceExt) }}
+	poolFormatExt                       = sync.Pool{New: func() interface{} { return new(FormatExt) }}
+	poolGeo                             = sync.Pool{New: func() interface{} { return new(Geo) }}
+	poolGeoExt                          = sync.Pool{New: func() interface{} { return new(GeoExt) }}
+	poolImpExt                          = sync.Pool{New: func() interface{} { return new(ImpExt) }}
+	poolMetricExt                       = sync.Pool{New: func() interface{} { return new(MetricExt) }}
+	poolNative                          = sync.Pool{New: func() interface{} { return new(Native) }}
+	poolNativeAssetExt                  = sync.Pool{New: func() interface{} { return new(NativeAssetExt) }}
+	poolNativeData                      = sync.Pool{New: func() interface{} { return new(NativeData) }}
+	poolNativeDataExt                   = sync.Pool{New: func() interface{} { return new(NativeDataExt) }}
+	poolNativeDataResponse              = sync.Pool{New: func() interface{} { return new(NativeDataResponse) }}
+	poolNativeDataResponseExt           = sync.Pool{New: func() interface{} { return new(NativeDataResponseExt) }}
+	poolNativeEventTrackerExt           = sync.Pool{New: func() interface{} { return new(NativeEventTrackerExt) }}
+	poolNativeEventTrackerResponseExt   = sync.Pool{New: func() interface{} { return new(NativeEventTrackerResponseExt) }}
+	poolNativeExt                       = sync.Pool{New: func() interface{} { return new(NativeExt) }}
+	poolNativeImage                     = sync.Pool{New: func() interface{} { return new(NativeImage) }}
+	poolNativeImageExt                  = sync.Pool{New: func() interface{} { return new(NativeImageExt) }}
+	poolNativeImageResponse             = sync.Pool{New: func() interface{} { return new(NativeImageResponse) }}
+	poolNativeImageResponseExt          = sync.Pool{New: func() interface{} { return new(NativeImageResponseExt) }}
+	poolNativeLink                      = sync.Pool{New: func() interface{} { return new(NativeLink) }}
+	poolNativeLinkExt                   = sync.Pool{New: func() interface{} { return new(NativeLinkExt) }}
+	poolNativeRequestExt                = sync.Pool{New: func() interface{} { return new(NativeRequestExt) }}
+	poolNativeResponseAssetExt          = sync.Pool{New: func() interface{} { return new(NativeResponseAssetExt) }}
+	poolNativeResponseExt               = sync.Pool{New: func() interface{} { return new(NativeResponseExt) }}
+	poolNativeTitle                     = sync.Pool{New: func() interface{} { return new(NativeTitle) }}
+	poolNativeTitleExt                  = sync.Pool{New: func() interface{} { return new(NativeTitleExt) }}
+	poolNativeTitleResponse             = sync.Pool{New: func() interface{} { return new(NativeTitleResponse) }}
+	poolNativeTitleResponseExt          = sync.Pool{New: func() interface{} { return new(NativeTitleResponseExt) }}
+	poolNativeVideo                     = sync.Pool{New: func() interface{} { return new(NativeVideo) }}
+	poolNativeVideoExt                  = sync.Pool{New: func() interface{} { return new(NativeVideoExt) }}
+	poolNativeVideoResponse             = sync.Pool{New: func() interface{} { return new(NativeVideoResponse) }}
+	poolPMP                             = sync.Pool{New: func() interface{} { return new(PMP) }}
+	poolPMPExt                          = sync.Pool{New: func() interface{} { return new(PMPExt) }}
+	poolProducer                        = sync.Pool{New: func() interface{} { return new(Producer) }}
+	poolProducerExt                     = sync.Pool{New: func() interface{} { return new(ProducerExt) }}
+	poolPublisherExt                    = sync.Pool{New: func() interface{} { return new(PublisherExt) }}
+	poolRegsExt                         = sync.Pool{New: func() interface{} { return new(RegsExt) }}
+	poolSegmentExt                      = sync.Pool{New: func() interface{} { return new(SegmentExt) }}
+	poolSite                            = sync.Pool{New: func() interface{} { return new(Site) }}
+	poolSiteExt                         = sync.Pool{New: func() interface{} { return new(SiteExt) }}
+	poolSource                          = sync.Pool{New: func() interface{} { return new(Source) }}
+	poolSourceExt                       = sync.Pool{New: func() interface{} { return new(SourceExt) }}
+	poolUserExt                         = sync.Pool{New: func() interface{} { return new(UserExt) }}
+	poolVideo                           = sync.Pool{New: func() interface{} { return new(Video) }}
+	poolVideoExt                        = sync.Pool{New: func() interface{} { return new(VideoExt) }}
+	poolAPIFrameworkSlice               slicePool[APIFramework]
+	poolBannerSlice                     slicePool[Banner]
+	poolBidSlice                        slicePool[Bid]
+	poolCompanionTypeSlice              slicePool[CompanionType]
+	poolContentDeliverySlice            slicePool[ContentDelivery]
+	poolCreativeAttributeSlice          slicePool[CreativeAttribute]
+	poolDataSlice                       slicePool[Data]
+	poolDealSlice                       slicePool[Deal]
+	poolEventTrackingMethodSlice        slicePool[EventTrackingMethod]
+	poolFormatSlice                     slicePool[Format]
+	poolImpSlice                        slicePool[Imp]
+	poolMetricSlice                     slicePool[Metric]
+	poolNativeAssetSlice                slicePool[NativeAsset]
+	poolNativeEventTrackerSlice         slicePool[NativeEventTracker]
+	poolNativeEventTrackerResponseSlice slicePool[NativeEventTrackerResponse]
+	poolNativeResponseAssetSlice        slicePool[NativeResponseAsset]
+	poolPlaybackMethodSlice             slicePool[PlaybackMethod]
+	poolProtocolSlice                   slicePool[Protocol]
+	poolSeatbidSlice                    slicePool[Seatbid]
+	poolSegmentSlice                    slicePool[Segment]
+	poolIntSlice                        slicePool[int]
+	poolStringSlice                     slicePool[string]
+)
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *App) Reset() {
+	poolStringSlice.put(x.Cat)
+	poolStringSlice.put(x.SectionCat)
+	poolStringSlice.put(x.PageCat)
+	x.Publisher.Reset()
+	if x.Content != nil {
+		x.Content.Reset()
+		poolContent.Put(x.Content)
+	}
+	poolStringSlice.put(x.Keywords)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolAppExt.Put(x.Ext)
+	}
+	*x = App{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *AppExt) Reset() {
+	*x = AppExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Audio) Reset() {
+	poolStringSlice.put(x.Mimes)
+	poolProtocolSlice.put(x.Protocols)
+	poolCreativeAttributeSlice.put(x.Battr)
+	poolContentDeliverySlice.put(x.Delivery)
+	if x.CompanionAd != nil {
+		for i, s := 0, x.CompanionAd[:cap(x.CompanionAd)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolBannerSlice.put(x.CompanionAd)
+	}
+	poolAPIFrameworkSlice.put(x.API)
+	poolCompanionTypeSlice.put(x.CompanionType)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolAudioExt.Put(x.Ext)
+	}
+	*x = Audio{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *AudioExt) Reset() {
+	*x = AudioExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Banner) Reset() {
+	poolCreativeAttributeSlice.put(x.BAttr)
+	if x.Format != nil {
+		for i, s := 0, x.Format[:cap(x.Format)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolFormatSlice.put(x.Format)
+	}
+	poolAPIFrameworkSlice.put(x.API)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolBannerExt.Put(x.Ext)
+	}
+	*x = Banner{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *BannerExt) Reset() {
+	*x = BannerExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Bid) Reset() {
+	poolStringSlice.put(x.Adomain)
+	poolStringSlice.put(x.Cat)
+	poolCreativeAttributeSlice.put(x.Attr)
+	x.Ext.Reset()
+	*x = Bid{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *BidExt) Reset() {
+	*x = BidExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *BidResponse) Reset() {
+	if x.SeatBid != nil {
+		for i, s := 0, x.SeatBid[:cap(x.SeatBid)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolSeatbidSlice.put(x.SeatBid)
+	}
+	x.Ext.Reset()
+	*x = BidResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *BidResponseExt) Reset() {
+	*x = BidResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Content) Reset() {
+	if x.Producer != nil {
+		x.Producer.Reset()
+		poolProducer.Put(x.Producer)
+	}
+	poolStringSlice.put(x.Cat)
+	poolStringSlice.put(x.Keywords)
+	if x.Data != nil {
+		for i, s := 0, x.Data[:cap(x.Data)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolDataSlice.put(x.Data)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolContentExt.Put(x.Ext)
+	}
+	*x = Content{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *ContentExt) Reset() {
+	*x = ContentExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Data) Reset() {
+	if x.Segment != nil {
+		for i, s := 0, x.Segment[:cap(x.Segment)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolSegmentSlice.put(x.Segment)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolDataExt.Put(x.Ext)
+	}
+	*x = Data{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *DataExt) Reset() {
+	*x = DataExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Deal) Reset() {
+	poolStringSlice.put(x.WSeat)
+	poolStringSlice.put(x.WAdomain)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolDealExt.Put(x.Ext)
+	}
+	*x = Deal{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *DealExt) Reset() {
+	*x = DealExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Device) Reset() {
+	if x.Geo != nil {
+		x.Geo.Reset()
+		poolGeo.Put(x.Geo)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolDeviceExt.Put(x.Ext)
+	}
+	*x = Device{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *DeviceExt) Reset() {
+	*x = DeviceExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Format) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolFormatExt.Put(x.Ext)
+	}
+	*x = Format{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *FormatExt) Reset() {
+	*x = FormatExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Geo) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolGeoExt.Put(x.Ext)
+	}
+	*x = Geo{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *GeoExt) Reset() {
+	*x = GeoExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Imp) Reset() {
+	if x.Metric != nil {
+		for i, s := 0, x.Metric[:cap(x.Metric)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolMetricSlice.put(x.Metric)
+	}
+	if x.Banner != nil {
+		x.Banner.Reset()
+		poolBanner.Put(x.Banner)
+	}
+	if x.Video != nil {
+		x.Video.Reset()
+		poolVideo.Put(x.Video)
+	}
+	if x.Audio != nil {
+		x.Audio.Reset()
+		poolAudio.Put(x.Audio)
+	}
+	if x.Native != nil {
+		x.Native.Reset()
+		poolNative.Put(x.Native)
+	}
+	if x.PMP != nil {
+		x.PMP.Reset()
+		poolPMP.Put(x.PMP)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolImpExt.Put(x.Ext)
+	}
+	*x = Imp{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *ImpExt) Reset() {
+	*x = ImpExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Metric) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolMetricExt.Put(x.Ext)
+	}
+	*x = Metric{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *MetricExt) Reset() {
+	*x = MetricExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Native) Reset() {
+	poolAPIFrameworkSlice.put(x.API)
+	poolCreativeAttributeSlice.put(x.Battr)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeExt.Put(x.Ext)
+	}
+	*x = Native{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeAsset) Reset() {
+	if x.Title != nil {
+		x.Title.Reset()
+		poolNativeTitle.Put(x.Title)
+	}
+	if x.Img != nil {
+		x.Img.Reset()
+		poolNativeImage.Put(x.Img)
+	}
+	if x.Video != nil {
+		x.Video.Reset()
+		poolNativeVideo.Put(x.Video)
+	}
+	if x.Data != nil {
+		x.Data.Reset()
+		poolNativeData.Put(x.Data)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeAssetExt.Put(x.Ext)
+	}
+	*x = NativeAsset{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeAssetExt) Reset() {
+	*x = NativeAssetExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeData) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeDataExt.Put(x.Ext)
+	}
+	*x = NativeData{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeDataExt) Reset() {
+	*x = NativeDataExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeDataResponse) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeDataResponseExt.Put(x.Ext)
+	}
+	*x = NativeDataResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeDataResponseExt) Reset() {
+	*x = NativeDataResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeEventTracker) Reset() {
+	poolEventTrackingMethodSlice.put(x.Methods)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeEventTrackerExt.Put(x.Ext)
+	}
+	*x = NativeEventTracker{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeEventTrackerExt) Reset() {
+	*x = NativeEventTrackerExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeEventTrackerResponse) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeEventTrackerResponseExt.Put(x.Ext)
+	}
+	*x = NativeEventTrackerResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeEventTrackerResponseExt) Reset() {
+	*x = NativeEventTrackerResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeExt) Reset() {
+	*x = NativeExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeImage) Reset() {
+	poolStringSlice.put(x.Mimes)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeImageExt.Put(x.Ext)
+	}
+	*x = NativeImage{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeImageExt) Reset() {
+	*x = NativeImageExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeImageResponse) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeImageResponseExt.Put(x.Ext)
+	}
+	*x = NativeImageResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeImageResponseExt) Reset() {
+	*x = NativeImageResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeLink) Reset() {
+	poolStringSlice.put(x.ClickTrackers)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeLinkExt.Put(x.Ext)
+	}
+	*x = NativeLink{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeLinkExt) Reset() {
+	*x = NativeLinkExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeRequest) Reset() {
+	if x.Assets != nil {
+		for i, s := 0, x.Assets[:cap(x.Assets)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolNativeAssetSlice.put(x.Assets)
+	}
+	if x.EventTrackers != nil {
+		for i, s := 0, x.EventTrackers[:cap(x.EventTrackers)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolNativeEventTrackerSlice.put(x.EventTrackers)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeRequestExt.Put(x.Ext)
+	}
+	*x = NativeRequest{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeRequestExt) Reset() {
+	*x = NativeRequestExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeResponse) Reset() {
+	if x.Assets != nil {
+		for i, s := 0, x.Assets[:cap(x.Assets)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolNativeResponseAssetSlice.put(x.Assets)
+	}
+	x.Link.Reset()
+	poolStringSlice.put(x.ImpTrackers)
+	if x.EventTrackers != nil {
+		for i, s := 0, x.EventTrackers[:cap(x.EventTrackers)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolNativeEventTrackerResponseSlice.put(x.EventTrackers)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeResponseExt.Put(x.Ext)
+	}
+	*x = NativeResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeResponseAsset) Reset() {
+	if x.Title != nil {
+		x.Title.Reset()
+		poolNativeTitleResponse.Put(x.Title)
+	}
+	if x.Img != nil {
+		x.Img.Reset()
+		poolNativeImageResponse.Put(x.Img)
+	}
+	if x.Video != nil {
+		x.Video.Reset()
+		poolNativeVideoResponse.Put(x.Video)
+	}
+	if x.Data != nil {
+		x.Data.Reset()
+		poolNativeDataResponse.Put(x.Data)
+	}
+	if x.Link != nil {
+		x.Link.Reset()
+		poolNativeLink.Put(x.Link)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeResponseAssetExt.Put(x.Ext)
+	}
+	*x = NativeResponseAsset{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeResponseAssetExt) Reset() {
+	*x = NativeResponseAssetExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeResponseExt) Reset() {
+	*x = NativeResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeTitle) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeTitleExt.Put(x.Ext)
+	}
+	*x = NativeTitle{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeTitleExt) Reset() {
+	*x = NativeTitleExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeTitleResponse) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeTitleResponseExt.Put(x.Ext)
+	}
+	*x = NativeTitleResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeTitleResponseExt) Reset() {
+	*x = NativeTitleResponseExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeVideo) Reset() {
+	poolStringSlice.put(x.Mimes)
+	poolProtocolSlice.put(x.Protocols)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolNativeVideoExt.Put(x.Ext)
+	}
+	*x = NativeVideo{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeVideoExt) Reset() {
+	*x = NativeVideoExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *NativeVideoResponse) Reset() {
+	*x = NativeVideoResponse{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *PMP) Reset() {
+	if x.Deals != nil {
+		for i, s := 0, x.Deals[:cap(x.Deals)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolDealSlice.put(x.Deals)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolPMPExt.Put(x.Ext)
+	}
+	*x = PMP{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *PMPExt) Reset() {
+	*x = PMPExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Producer) Reset() {
+	poolStringSlice.put(x.Cat)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolProducerExt.Put(x.Ext)
+	}
+	*x = Producer{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *ProducerExt) Reset() {
+	*x = ProducerExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Publisher) Reset() {
+	poolStringSlice.put(x.Cat)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolPublisherExt.Put(x.Ext)
+	}
+	*x = Publisher{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *PublisherExt) Reset() {
+	*x = PublisherExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Regs) Reset() {
+	poolIntSlice.put(x.GPPSID)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolRegsExt.Put(x.Ext)
+	}
+	*x = Regs{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *RegsExt) Reset() {
+	*x = RegsExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Request) Reset() {
+	if x.Imp != nil {
+		for i, s := 0, x.Imp[:cap(x.Imp)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolImpSlice.put(x.Imp)
+	}
+	if x.App != nil {
+		x.App.Reset()
+		poolApp.Put(x.App)
+	}
+	if x.Site != nil {
+		x.Site.Reset()
+		poolSite.Put(x.Site)
+	}
+	x.Device.Reset()
+	x.Format.Reset()
+	x.User.Reset()
+	poolStringSlice.put(x.WSeat)
+	poolStringSlice.put(x.BSeat)
+	poolStringSlice.put(x.Cur)
+	poolStringSlice.put(x.Wlang)
+	poolStringSlice.put(x.Bcat)
+	poolStringSlice.put(x.BAdv)
+	poolStringSlice.put(x.BApp)
+	if x.Source != nil {
+		x.Source.Reset()
+		poolSource.Put(x.Source)
+	}
+	x.Regs.Reset()
+	x.Ext.Reset()
+	*x = Request{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *RequestExt) Reset() {
+	*x = RequestExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Seatbid) Reset() {
+	if x.Bid != nil {
+		for i, s := 0, x.Bid[:cap(x.Bid)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolBidSlice.put(x.Bid)
+	}
+	x.Ext.Reset()
+	*x = Seatbid{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *SeatbidExt) Reset() {
+	*x = SeatbidExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Segment) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolSegmentExt.Put(x.Ext)
+	}
+	*x = Segment{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *SegmentExt) Reset() {
+	*x = SegmentExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Site) Reset() {
+	poolStringSlice.put(x.Cat)
+	poolStringSlice.put(x.SectionCat)
+	poolStringSlice.put(x.PageCat)
+	x.Publisher.Reset()
+	if x.Content != nil {
+		x.Content.Reset()
+		poolContent.Put(x.Content)
+	}
+	poolStringSlice.put(x.Keywords)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolSiteExt.Put(x.Ext)
+	}
+	*x = Site{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *SiteExt) Reset() {
+	*x = SiteExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Source) Reset() {
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolSourceExt.Put(x.Ext)
+	}
+	*x = Source{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *SourceExt) Reset() {
+	*x = SourceExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *User) Reset() {
+	if x.Geo != nil {
+		x.Geo.Reset()
+		poolGeo.Put(x.Geo)
+	}
+	if x.Data != nil {
+		for i, s := 0, x.Data[:cap(x.Data)]; i < len(s); i++ {
+			s[i].Reset()
+		}
+		poolDataSlice.put(x.Data)
+	}
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolUserExt.Put(x.Ext)
+	}
+	*x = User{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *UserExt) Reset() {
+	*x = UserExt{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *Video) Reset() {
+	poolStringSlice.put(x.Mimes)
+	poolProtocolSlice.put(x.Protocols)
+	poolPlaybackMethodSlice.put(x.Playbackmethod)
+	poolContentDeliverySlice.put(x.Delivery)
+	poolAPIFrameworkSlice.put(x.API)
+	if x.Ext != nil {
+		x.Ext.Reset()
+		poolVideoExt.Put(x.Ext)
+	}
+	*x = Video{}
+}
+
+// Reset zeroes x, returning the objects and slices it points to into pools for reuse. Nothing x pointed
+// to may be used after
+func (x *VideoExt) Reset() {
+	*x = VideoExt{}
+}
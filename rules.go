@@ -0,0 +1,144 @@
+package twofive
+
+import "strconv"
+
+// Rule is a request level semantic check, used for the constraints that span more than one field and so can't
+// be expressed as a struct tag. Rules are run by Validate after the struct tags have been checked
+type Rule interface {
+	Check(r *Request) ValidationErrors
+}
+
+// RuleFunc adapts an ordinary function to a Rule
+type RuleFunc func(r *Request) ValidationErrors
+
+// Check calls f(r)
+func (f RuleFunc) Check(r *Request) ValidationErrors { return f(r) }
+
+// DefaultRules are the rules run by Validate
+var DefaultRules = []Rule{
+	RuleFunc(InventoryRule),
+	RuleFunc(ImpMediaRule),
+	RuleFunc(UniqueImpIDRule),
+	RuleFunc(DurationRule),
+	RuleFunc(BitRateRule),
+	RuleFunc(BannerSizeRule),
+	RuleFunc(AuctionTypeRule),
+}
+
+// RegisterRule adds a rule to DefaultRules so that it's run by every call to Validate. It's not safe for
+// concurrent use with Validate and so should be called on init
+func RegisterRule(rule Rule) {
+	DefaultRules = append(DefaultRules, rule)
+}
+
+// InventoryRule requires exactly one of app or site
+func InventoryRule(r *Request) ValidationErrors {
+	switch r.ValidateInventory() {
+	case ErrNoInventory:
+		return ValidationErrors{{Path: "app", Rule: "oneof(app|site)"}}
+	case ErrAppAndSite:
+		return ValidationErrors{{Path: "site", Rule: "oneof(app|site)"}}
+	}
+	return nil
+}
+
+// ImpMediaRule requires each impression to offer at least one of banner, video, audio or native
+func ImpMediaRule(r *Request) ValidationErrors {
+	var errs ValidationErrors
+	for i, imp := range r.Imp {
+		if imp.Banner == nil && imp.Video == nil && imp.Audio == nil && imp.Native == nil {
+			errs = append(errs, ValidationError{Path: impPath(i), Rule: "oneof(banner|video|audio|native)"})
+		}
+	}
+	return errs
+}
+
+// UniqueImpIDRule requires the impression ids to be unique within the request so bids can reference them
+func UniqueImpIDRule(r *Request) ValidationErrors {
+	var errs ValidationErrors
+	seen := make(map[string]bool, len(r.Imp))
+	for i, imp := range r.Imp {
+		if imp.ID == "" {
+			continue
+		}
+		if seen[imp.ID] {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".id", Rule: "unique", Value: imp.ID})
+		}
+		seen[imp.ID] = true
+	}
+	return errs
+}
+
+// DurationRule requires the minimum video and audio durations to not exceed the maximums
+func DurationRule(r *Request) ValidationErrors {
+	var errs ValidationErrors
+	for i, imp := range r.Imp {
+		if v := imp.Video; v != nil && v.Maxduration > 0 && v.Minduration > v.Maxduration {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".video.minduration", Rule: "ltefield(maxduration)", Value: v.Minduration})
+		}
+		if a := imp.Audio; a != nil && a.Maxduration > 0 && a.Minduration > a.Maxduration {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".audio.minduration", Rule: "ltefield(maxduration)", Value: a.Minduration})
+		}
+	}
+	return errs
+}
+
+// BitRateRule requires the minimum video and audio bit rates to not exceed the maximums
+func BitRateRule(r *Request) ValidationErrors {
+	var errs ValidationErrors
+	for i, imp := range r.Imp {
+		if v := imp.Video; v != nil && v.MaxBitRate > 0 && v.MinBitRate > v.MaxBitRate {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".video.minbitrate", Rule: "ltefield(maxbitrate)", Value: v.MinBitRate})
+		}
+		if a := imp.Audio; a != nil && a.MaxBitRate > 0 && a.MinBitRate > a.MaxBitRate {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".audio.minbitrate", Rule: "ltefield(maxbitrate)", Value: a.MinBitRate})
+		}
+	}
+	return errs
+}
+
+// BannerSizeRule requires each banner to have either a w and h or at least one format. The non spec request
+// level format is accepted in place of both to backwards support the GET
+func BannerSizeRule(r *Request) ValidationErrors {
+	if r.Format.W > 0 && r.Format.H > 0 {
+		return nil
+	}
+
+	var errs ValidationErrors
+	for i, imp := range r.Imp {
+		if b := imp.Banner; b != nil && (b.W == 0 || b.H == 0) && len(b.Format) == 0 {
+			errs = append(errs, ValidationError{Path: impPath(i) + ".banner", Rule: "oneof(w,h|format)"})
+		}
+	}
+	return errs
+}
+
+// AuctionTypeRule requires first and second price deals to agree with the request's auction type, which
+// defaults to second price, and fixed price deals to carry the agreed upon price in their bid floor
+func AuctionTypeRule(r *Request) ValidationErrors {
+	at := r.At
+	if at == 0 {
+		at = 2
+	}
+
+	var errs ValidationErrors
+	for i, imp := range r.Imp {
+		if imp.PMP == nil {
+			continue
+		}
+		for j, d := range imp.PMP.Deals {
+			path := impPath(i) + ".pmp.deals[" + strconv.Itoa(j) + "]"
+			switch {
+			case (d.At == 1 || d.At == 2) && d.At != at:
+				errs = append(errs, ValidationError{Path: path + ".at", Rule: "eqfield(at)", Value: d.At})
+			case d.At == 3 && d.BidFloor <= 0:
+				errs = append(errs, ValidationError{Path: path + ".bidfloor", Rule: "gt(0)", Value: d.BidFloor})
+			}
+		}
+	}
+	return errs
+}
+
+func impPath(i int) string {
+	return "imp[" + strconv.Itoa(i) + "]"
+}
@@ -0,0 +1,124 @@
+package twofive
+
+import (
+	"reflect"
+	"testing"
+)
+
+// staticImpID is the id of the impression in static_bid_request.json
+const staticImpID = "73e64f05-5dcf-488d-a4d4-34b2dd20b4b9"
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   RuleFunc
+		mutate func(r *Request)
+		want   ValidationErrors
+	}{
+		{
+			name: "Imp Without Media",
+			rule: ImpMediaRule,
+			mutate: func(r *Request) {
+				r.Imp = append(r.Imp, Imp{ID: "2"})
+			},
+			want: ValidationErrors{{Path: "imp[1]", Rule: "oneof(banner|video|audio|native)"}},
+		},
+		{
+			name: "Duplicate Imp ID",
+			rule: UniqueImpIDRule,
+			mutate: func(r *Request) {
+				r.Imp = append(r.Imp, r.Imp[0])
+			},
+			want: ValidationErrors{{Path: "imp[1].id", Rule: "unique", Value: staticImpID}},
+		},
+		{
+			name: "Min Duration Over Max",
+			rule: DurationRule,
+			mutate: func(r *Request) {
+				r.Imp[0].Video = &Video{Minduration: 30, Maxduration: 15}
+				r.Imp[0].Audio = &Audio{Minduration: 5, Maxduration: 15}
+			},
+			want: ValidationErrors{{Path: "imp[0].video.minduration", Rule: "ltefield(maxduration)", Value: 30}},
+		},
+		{
+			name: "Min Bit Rate Over Max",
+			rule: BitRateRule,
+			mutate: func(r *Request) {
+				r.Imp[0].Audio = &Audio{MinBitRate: 320, MaxBitRate: 128}
+			},
+			want: ValidationErrors{{Path: "imp[0].audio.minbitrate", Rule: "ltefield(maxbitrate)", Value: 320}},
+		},
+		{
+			name: "Banner Without Size",
+			rule: BannerSizeRule,
+			mutate: func(r *Request) {
+				r.Format = Format{}
+				r.Imp[0].Banner.W = 0
+			},
+			want: ValidationErrors{{Path: "imp[0].banner", Rule: "oneof(w,h|format)"}},
+		},
+		{
+			name: "Banner With Format",
+			rule: BannerSizeRule,
+			mutate: func(r *Request) {
+				r.Format = Format{}
+				r.Imp[0].Banner.W, r.Imp[0].Banner.H = 0, 0
+				r.Imp[0].Banner.Format = []Format{{W: 320, H: 50}}
+			},
+		},
+		{
+			name: "Deal Auction Type",
+			rule: AuctionTypeRule,
+			mutate: func(r *Request) {
+				r.Imp[0].PMP = &PMP{Deals: []Deal{
+					{ID: "1", At: 1},
+					{ID: "2", At: 2},
+					{ID: "3", At: 3},
+					{ID: "4", At: 3, BidFloor: 5},
+				}}
+			},
+			want: ValidationErrors{
+				{Path: "imp[0].pmp.deals[1].at", Rule: "eqfield(at)", Value: 2},
+				{Path: "imp[0].pmp.deals[2].bidfloor", Rule: "gt(0)", Value: float64(0)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := loadValidRequest(t, "./test_data/static_bid_request.json")
+			if got := tt.rule(r); got != nil {
+				t.Fatalf("rule failed before mutation: %v", got)
+			}
+
+			tt.mutate(r)
+			if got := tt.rule(r); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateWith(t *testing.T) {
+	r := loadValidRequest(t, "./test_data/static_bid_request.json")
+	want := ValidationErrors{{Path: "device.os", Rule: "in(android)", Value: "ios"}}
+	androidOnly := RuleFunc(func(r *Request) ValidationErrors {
+		if r.Device.OS != "android" {
+			return want
+		}
+		return nil
+	})
+
+	if err := r.ValidateWith(androidOnly); !reflect.DeepEqual(err, want) {
+		t.Errorf("ValidateWith() = %v, want %v", err, want)
+	}
+	if err := r.Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	defer func(rules []Rule) { DefaultRules = rules }(DefaultRules)
+	RegisterRule(androidOnly)
+	if err := r.Validate(); !reflect.DeepEqual(err, want) {
+		t.Errorf("Validate() after RegisterRule = %v, want %v", err, want)
+	}
+}
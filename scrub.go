@@ -0,0 +1,149 @@
+package twofive
+
+import (
+	"math"
+	"net"
+	"strings"
+)
+
+// ScrubRule is a set of the rules Scrub applies to the personal data of a request, the rules are flags to be
+// combined with |
+type ScrubRule int
+
+// The rules of Scrub
+const (
+	ScrubIP           ScrubRule = 1 << iota // truncate device.ip to its /24 and device.ipv6 to its /64
+	ScrubGeo                                // round the lat and lon of device.geo and user.geo
+	ScrubIFA                                // zero device.ifa
+	ScrubUserIDs                            // drop user.id and user.buyeruid
+	ScrubDemographics                       // drop user.yob, user.age and user.gender
+)
+
+// ScrubAll is every rule of Scrub
+const ScrubAll = ScrubIP | ScrubGeo | ScrubIFA | ScrubUserIDs | ScrubDemographics
+
+var scrubRuleNames = []string{"ip", "geo", "ifa", "userids", "demographics"}
+
+// String returns the names of the rules in the set joined by |, e.g. ip|geo
+func (s ScrubRule) String() string {
+	var names []string
+	for i, name := range scrubRuleNames {
+		if s&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// Has reports if every rule of rule is in the set
+func (s ScrubRule) Has(rule ScrubRule) bool {
+	return s&rule == rule
+}
+
+// Policy is the rules Scrub applies for each regulation or signal of a request, a regulation without rules
+// leaves the request as is
+type Policy struct {
+	COPPA ScrubRule // applied when regs.coppa is 1
+	LMT   ScrubRule // applied when device.lmt is 1
+	DNT   ScrubRule // applied when device.dnt is 1
+	GDPR  ScrubRule // applied when the GDPR applies without the consent of GDPRVendorID
+	CCPA  ScrubRule // applied when the user opted out of the sale of their personal data
+
+	// GDPRVendorID and GDPRPurposes are the vendor and purposes the TC string must give consent to as the
+	// HasVendorConsent of TCString checks, with no vendor the GDPR rules apply whenever the GDPR does
+	GDPRVendorID int
+	GDPRPurposes []int
+
+	// GeoDecimals is the number of decimals ScrubGeo rounds lat and lon to, 2 is about a kilometre
+	GeoDecimals int
+}
+
+// DefaultPolicy scrubs everything for COPPA, and the identifiers and precise location of the user for the other
+// regulations and signals
+var DefaultPolicy = Policy{
+	COPPA:       ScrubAll,
+	LMT:         ScrubIFA | ScrubUserIDs,
+	DNT:         ScrubIFA | ScrubUserIDs,
+	GDPR:        ScrubIP | ScrubGeo | ScrubIFA | ScrubUserIDs,
+	CCPA:        ScrubIP | ScrubGeo | ScrubIFA | ScrubUserIDs,
+	GeoDecimals: 2,
+}
+
+// Scrub removes the personal data of the request the policy calls for, by the regulations that apply to it and
+// the signals it carries as its PrivacySignals derives them, and returns the rules that fired. Rules fire by
+// applying, whether or not the request had the data they remove
+func Scrub(req *Request, policy Policy) ScrubRule {
+	signals, _ := req.PrivacySignals()
+	var rules ScrubRule
+	if req.Regs.Coppa == 1 {
+		rules |= policy.COPPA
+	}
+	if req.Device.Lmt == 1 {
+		rules |= policy.LMT
+	}
+	if req.Device.Dnt == 1 {
+		rules |= policy.DNT
+	}
+	if signals.GDPR && (policy.GDPRVendorID == 0 || signals.TCString == nil ||
+		!signals.TCString.HasVendorConsent(policy.GDPRVendorID, policy.GDPRPurposes...)) {
+		rules |= policy.GDPR
+	}
+	if signals.SaleOptOut {
+		rules |= policy.CCPA
+	}
+
+	if rules.Has(ScrubIP) {
+		req.Device.IP = truncateIP(req.Device.IP, 24, 32)
+		req.Device.IPv6 = truncateIP(req.Device.IPv6, 64, 128)
+	}
+	if rules.Has(ScrubGeo) {
+		roundGeo(req.Device.Geo, policy.GeoDecimals)
+		roundGeo(req.User.Geo, policy.GeoDecimals)
+	}
+	if rules.Has(ScrubIFA) {
+		req.Device.Ifa = ""
+	}
+	if rules.Has(ScrubUserIDs) {
+		req.User.ID = ""
+		req.User.BuyerUID = ""
+	}
+	if rules.Has(ScrubDemographics) {
+		req.User.YOB = 0
+		req.User.Age = 0
+		req.User.Gender = ""
+	}
+	return rules
+}
+
+// ScrubPersonalData scrubs the requests sent to a partner as Scrub does with the policy
+func ScrubPersonalData(policy Policy) Adapter {
+	return AdapterFunc(func(req *Request) error {
+		Scrub(req, policy)
+		return nil
+	})
+}
+
+// truncateIP keeps the first ones bits of an address of bits bits, an address that isn't one is dropped
+func truncateIP(s string, ones, bits int) string {
+	if s == "" {
+		return ""
+	}
+	ip := net.ParseIP(s)
+	if bits == 32 {
+		ip = ip.To4()
+	}
+	if ip == nil {
+		return ""
+	}
+	return ip.Mask(net.CIDRMask(ones, bits)).String()
+}
+
+// roundGeo rounds the lat and lon of geo to the decimals
+func roundGeo(geo *Geo, decimals int) {
+	if geo == nil {
+		return
+	}
+	scale := math.Pow10(decimals)
+	geo.Lat = math.Round(geo.Lat*scale) / scale
+	geo.Lon = math.Round(geo.Lon*scale) / scale
+}
@@ -0,0 +1,133 @@
+package twofive
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestScrub(t *testing.T) {
+	consent := coreSegment([]int{1, 2}, 4).int(0, 16).bool(false).int(0, 12).String()
+	personal := func() *Request {
+		return &Request{
+			Device: Device{
+				IP:   "203.0.113.195",
+				IPv6: "2001:db8:85a3:8d3:1319:8a2e:370:7348",
+				Ifa:  "6d92078a-8246-4ba4-ae5b-76104861e7dc",
+				Geo:  &Geo{Lat: 51.507351, Lon: -0.127758, Country: "GBR"},
+			},
+			User: User{
+				ID:       "u1",
+				BuyerUID: "b1",
+				YOB:      1984,
+				Age:      38,
+				Gender:   "F",
+				Geo:      &Geo{Lat: 40.712776, Lon: -74.005974},
+				Ext:      &UserExt{Consent: consent},
+			},
+		}
+	}
+	scrubbed := func(rules ScrubRule) *Request {
+		req := personal()
+		if rules.Has(ScrubIP) {
+			req.Device.IP = "203.0.113.0"
+			req.Device.IPv6 = "2001:db8:85a3:8d3::"
+		}
+		if rules.Has(ScrubGeo) {
+			req.Device.Geo.Lat, req.Device.Geo.Lon = 51.51, -0.13
+			req.User.Geo.Lat, req.User.Geo.Lon = 40.71, -74.01
+		}
+		if rules.Has(ScrubIFA) {
+			req.Device.Ifa = ""
+		}
+		if rules.Has(ScrubUserIDs) {
+			req.User.ID, req.User.BuyerUID = "", ""
+		}
+		if rules.Has(ScrubDemographics) {
+			req.User.YOB, req.User.Age, req.User.Gender = 0, 0, ""
+		}
+		return req
+	}
+	gdprRules := ScrubIP | ScrubGeo | ScrubIFA | ScrubUserIDs
+
+	tests := []struct {
+		name   string
+		mutate func(r *Request)
+		policy Policy
+		want   ScrubRule
+	}{
+		{name: "no regulation", policy: DefaultPolicy},
+		{name: "COPPA", mutate: func(r *Request) { r.Regs.Coppa = 1 }, policy: DefaultPolicy, want: ScrubAll},
+		{name: "LMT", mutate: func(r *Request) { r.Device.Lmt = 1 }, policy: DefaultPolicy, want: ScrubIFA | ScrubUserIDs},
+		{name: "DNT", mutate: func(r *Request) { r.Device.Dnt = 1 }, policy: DefaultPolicy, want: ScrubIFA | ScrubUserIDs},
+		{
+			name:   "DNT and CCPA",
+			mutate: func(r *Request) { r.Device.Dnt = 1; r.Regs.Ext = &RegsExt{USPrivacy: "1YYN"} },
+			policy: DefaultPolicy,
+			want:   gdprRules,
+		},
+		{name: "GDPR", mutate: func(r *Request) { r.Regs.Ext = &RegsExt{GDPR: 1} }, policy: DefaultPolicy, want: gdprRules},
+		{
+			name:   "GDPR without consent",
+			mutate: func(r *Request) { r.Regs.Ext = &RegsExt{GDPR: 1} },
+			policy: Policy{GDPR: gdprRules, GDPRVendorID: 3, GDPRPurposes: []int{1}, GeoDecimals: 2},
+			want:   gdprRules,
+		},
+		{
+			name:   "GDPR with consent",
+			mutate: func(r *Request) { r.Regs.Ext = &RegsExt{GDPR: 1} },
+			policy: Policy{GDPR: gdprRules, GDPRVendorID: 4, GDPRPurposes: []int{1}, GeoDecimals: 2},
+		},
+		{name: "not GDPR", mutate: func(r *Request) { r.Regs.Ext = &RegsExt{GDPR: 0} }, policy: DefaultPolicy},
+		{name: "CCPA", mutate: func(r *Request) { r.Regs.Ext = &RegsExt{USPrivacy: "1YYN"} }, policy: DefaultPolicy, want: gdprRules},
+		{name: "GPP opted out", mutate: func(r *Request) { r.Regs.GPP = gppHeader(6) + "~1YYN" }, policy: DefaultPolicy, want: gdprRules},
+		{name: "GPP GDPR", mutate: func(r *Request) { r.Regs.GPP = gppHeader(2) + "~" + consent }, policy: DefaultPolicy, want: gdprRules},
+		{name: "CCPA not opted out", mutate: func(r *Request) { r.Regs.Ext = &RegsExt{USPrivacy: "1YNN"} }, policy: DefaultPolicy},
+		{
+			name:   "CCPA demographics only",
+			mutate: func(r *Request) { r.Regs.Ext = &RegsExt{USPrivacy: "1YYN"} },
+			policy: Policy{CCPA: ScrubDemographics},
+			want:   ScrubDemographics,
+		},
+		{name: "COPPA without rules", mutate: func(r *Request) { r.Regs.Coppa = 1 }, policy: Policy{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, want := personal(), scrubbed(tt.want)
+			if tt.mutate != nil {
+				tt.mutate(req)
+				tt.mutate(want)
+			}
+			if got := Scrub(req, tt.policy); got != tt.want {
+				t.Errorf("Scrub() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(req, want) {
+				t.Errorf("got  %+v %+v\nwant %+v %+v", req.Device, req.User, want.Device, want.User)
+			}
+		})
+	}
+}
+
+func TestScrubPersonalData(t *testing.T) {
+	req := &Request{Regs: Regs{Coppa: 1}, Device: Device{IP: "not an ip", IPv6: "2001:db8::1", Ifa: "ifa"}}
+
+	var sent *Request
+	e := Exchange{Partners: []Partner{{
+		Name:     "partner",
+		Bidder:   BidderFunc(func(_ context.Context, r *Request) (*BidResponse, error) { sent = r; return nil, nil }),
+		Adapters: []Adapter{ScrubPersonalData(DefaultPolicy)},
+	}}}
+	if _, err := e.Run(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if sent.Device.IP != "" || sent.Device.IPv6 != "2001:db8::" || sent.Device.Ifa != "" {
+		t.Errorf("sent %+v", sent.Device)
+	}
+	if req.Device.Ifa != "ifa" {
+		t.Error("the request of the exchange was scrubbed")
+	}
+	if s := ScrubAll.String(); s != "ip|geo|ifa|userids|demographics" {
+		t.Errorf("String() = %s", s)
+	}
+}
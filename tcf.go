@@ -0,0 +1,363 @@
+package twofive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTCString is wrapped by the errors of ParseTCString
+var ErrInvalidTCString = errors.New("twofive: invalid TC string")
+
+// ErrNoVendorConsent is returned by the Adapter of RequireVendorConsent for a request without consent
+var ErrNoVendorConsent = errors.New("twofive: no vendor consent")
+
+// IDSet is a set of the purposes, special features or vendors of a TC string, the IDs of which start at 1
+type IDSet []bool
+
+// Has reports if the id is in the set
+func (s IDSet) Has(id int) bool {
+	return id >= 1 && id <= len(s) && s[id-1]
+}
+
+// IDs returns the ids in the set in order
+func (s IDSet) IDs() []int {
+	var ids []int
+	for i, ok := range s {
+		if ok {
+			ids = append(ids, i+1)
+		}
+	}
+	return ids
+}
+
+// IDRange is the ids from Start to End, both included
+type IDRange struct {
+	Start, End int
+}
+
+// IDRanges is a set of ids held as the ranges a TC string encodes it with, the sparse counterpart of an IDSet
+type IDRanges []IDRange
+
+// Has reports if the id is in one of the ranges
+func (s IDRanges) Has(id int) bool {
+	for _, r := range s {
+		if id >= r.Start && id <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// RestrictionType is how a publisher restricts the vendors' processing for a purpose
+type RestrictionType int
+
+// The restriction types of the TCF
+const (
+	RestrictionNotAllowed      RestrictionType = 0
+	RestrictionRequireConsent  RestrictionType = 1
+	RestrictionRequireInterest RestrictionType = 2
+)
+
+// PublisherRestriction restricts the vendors' processing for a purpose. The vendors are held as ranges, a
+// string can carry thousands of restrictions of every vendor id
+type PublisherRestriction struct {
+	PurposeID int
+	Type      RestrictionType
+	Vendors   IDRanges
+}
+
+// PublisherTC is the optional publisher purposes segment of a TC string, the consents and legitimate
+// interests of the publisher's own processing
+type PublisherTC struct {
+	PurposesConsent              IDSet
+	PurposesLITransparency       IDSet
+	CustomPurposesConsent        IDSet
+	CustomPurposesLITransparency IDSet
+}
+
+// TCString is an IAB TCF v2 transparency and consent string, as passed in UserExt.Consent. The core segment is
+// always present, DisclosedVendors, AllowedVendors and PublisherTC only when their optional segment is
+type TCString struct {
+	Version                   int
+	Created                   time.Time
+	LastUpdated               time.Time
+	CMPID                     int
+	CMPVersion                int
+	ConsentScreen             int
+	ConsentLanguage           string
+	VendorListVersion         int
+	PolicyVersion             int
+	IsServiceSpecific         bool
+	UseNonStandardTexts       bool
+	SpecialFeatureOptIns      IDSet
+	PurposesConsent           IDSet
+	PurposesLITransparency    IDSet
+	PurposeOneTreatment       bool
+	PublisherCC               string
+	VendorConsents            IDSet
+	VendorLegitimateInterests IDSet
+	PublisherRestrictions     []PublisherRestriction
+
+	DisclosedVendors IDSet
+	AllowedVendors   IDSet
+	PublisherTC      *PublisherTC
+}
+
+// the types of the optional segments
+const (
+	segmentDisclosedVendors = 1
+	segmentAllowedVendors   = 2
+	segmentPublisherTC      = 3
+)
+
+// ParseTCString decodes a TCF v2 TC string, its core segment and any of the optional segments that follow
+// it. Segments of an unknown type are skipped. The error wraps ErrInvalidTCString
+func ParseTCString(s string) (*TCString, error) {
+	segments := strings.Split(s, ".")
+	r, err := newBitReader(segments[0], ErrInvalidTCString)
+	if err != nil {
+		return nil, err
+	}
+
+	tc := &TCString{Version: r.int(6)}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if tc.Version != 2 {
+		return nil, fmt.Errorf("%w: version %d", ErrInvalidTCString, tc.Version)
+	}
+	tc.Created = r.time()
+	tc.LastUpdated = r.time()
+	tc.CMPID = r.int(12)
+	tc.CMPVersion = r.int(12)
+	tc.ConsentScreen = r.int(6)
+	tc.ConsentLanguage = r.letters()
+	tc.VendorListVersion = r.int(12)
+	tc.PolicyVersion = r.int(6)
+	tc.IsServiceSpecific = r.bool()
+	tc.UseNonStandardTexts = r.bool()
+	tc.SpecialFeatureOptIns = r.bits(12)
+	tc.PurposesConsent = r.bits(24)
+	tc.PurposesLITransparency = r.bits(24)
+	tc.PurposeOneTreatment = r.bool()
+	tc.PublisherCC = r.letters()
+	tc.VendorConsents = r.vendors()
+	tc.VendorLegitimateInterests = r.vendors()
+	for n := r.int(12); n > 0 && r.err == nil; n-- {
+		tc.PublisherRestrictions = append(tc.PublisherRestrictions, PublisherRestriction{
+			PurposeID: r.int(6),
+			Type:      RestrictionType(r.int(2)),
+			Vendors:   r.idRanges(),
+		})
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	for _, segment := range segments[1:] {
+		r, err := newBitReader(segment, ErrInvalidTCString)
+		if err != nil {
+			return nil, err
+		}
+		switch r.int(3) {
+		case segmentDisclosedVendors:
+			tc.DisclosedVendors = r.vendors()
+		case segmentAllowedVendors:
+			tc.AllowedVendors = r.vendors()
+		case segmentPublisherTC:
+			p := &PublisherTC{PurposesConsent: r.bits(24), PurposesLITransparency: r.bits(24)}
+			n := r.int(6)
+			p.CustomPurposesConsent = r.bits(n)
+			p.CustomPurposesLITransparency = r.bits(n)
+			tc.PublisherTC = p
+		}
+		if r.err != nil {
+			return nil, r.err
+		}
+	}
+	return tc, nil
+}
+
+// HasVendorConsent reports if the vendor has the user's consent for every purpose, and isn't restricted by the
+// publisher from processing for any of them on consent
+func (tc *TCString) HasVendorConsent(vendorID int, purposes ...int) bool {
+	if !tc.VendorConsents.Has(vendorID) {
+		return false
+	}
+	for _, p := range purposes {
+		if !tc.PurposesConsent.Has(p) {
+			return false
+		}
+		for _, pr := range tc.PublisherRestrictions {
+			if pr.PurposeID == p && pr.Type != RestrictionRequireConsent && pr.Vendors.Has(vendorID) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// HasVendorConsent reports if the vendor may process the user's data for the purposes. It may when the GDPR
+// doesn't apply, otherwise the TC string must give it consent as the HasVendorConsent of TCString does. A
+// missing or invalid TC string gives no consent
+func (s PrivacySignals) HasVendorConsent(vendorID int, purposes ...int) bool {
+	return !s.GDPR || (s.TCString != nil && s.TCString.HasVendorConsent(vendorID, purposes...))
+}
+
+// HasVendorConsent reports if the vendor may process the user's data for the purposes, by the PrivacySignals of
+// the request: the GDPR applies by regs.ext.gdpr or the TCF EU section of the GPP string, and the TC string is
+// that section or user.ext.consent. The regs.gdpr of OpenRTB 2.6 isn't read, FromV26 moves it to regs.ext. The
+// strings are decoded on every call, derive the PrivacySignals once to check many vendors
+func (r *Request) HasVendorConsent(vendorID int, purposes ...int) bool {
+	signals, _ := r.PrivacySignals()
+	return signals.HasVendorConsent(vendorID, purposes...)
+}
+
+// RequireVendorConsent fails the requests the vendor doesn't have consent for the purposes for with
+// ErrNoVendorConsent, so that an Exchange doesn't send them to the partner
+func RequireVendorConsent(vendorID int, purposes ...int) Adapter {
+	return AdapterFunc(func(req *Request) error {
+		if !req.HasVendorConsent(vendorID, purposes...) {
+			return ErrNoVendorConsent
+		}
+		return nil
+	})
+}
+
+// bitReader reads the big endian bit fields of a segment, the first error stops the reading. The errors wrap
+// invalid, the error of the string the segment is of
+type bitReader struct {
+	b       []byte
+	pos     int
+	err     error
+	invalid error
+}
+
+func newBitReader(segment string, invalid error) (*bitReader, error) {
+	// the segments are unpadded base64url of any length, each character being six bits. Padding and the
+	// standard alphabet are tolerated
+	segment = strings.TrimRight(segment, "=")
+	b := make([]byte, (len(segment)*6+7)/8)
+	for i := 0; i < len(segment); i++ {
+		v := strings.IndexByte(base64URL, segment[i])
+		switch {
+		case segment[i] == '+':
+			v = 62
+		case segment[i] == '/':
+			v = 63
+		case v < 0:
+			return nil, fmt.Errorf("%w: illegal base64 data at input byte %d", invalid, i)
+		}
+		for bit := 0; bit < 6; bit++ {
+			if v&(0x20>>bit) != 0 {
+				pos := i*6 + bit
+				b[pos/8] |= 0x80 >> (pos % 8)
+			}
+		}
+	}
+	return &bitReader{b: b, invalid: invalid}, nil
+}
+
+const base64URL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+func (r *bitReader) int(n int) int {
+	if r.err != nil {
+		return 0
+	}
+	if r.pos+n > len(r.b)*8 {
+		r.err = fmt.Errorf("%w: %d bits short", r.invalid, r.pos+n-len(r.b)*8)
+		return 0
+	}
+	v := 0
+	for i := 0; i < n; i++ {
+		v <<= 1
+		if r.b[r.pos/8]&(0x80>>(r.pos%8)) != 0 {
+			v |= 1
+		}
+		r.pos++
+	}
+	return v
+}
+
+func (r *bitReader) bool() bool {
+	return r.int(1) == 1
+}
+
+// time reads a time in deciseconds
+func (r *bitReader) time() time.Time {
+	ds := int64(r.int(36))
+	return time.Unix(ds/10, ds%10*int64(100*time.Millisecond)).UTC()
+}
+
+// letters reads two letters of six bits each, A being 0
+func (r *bitReader) letters() string {
+	b := []byte{byte(r.int(6)), byte(r.int(6))}
+	for i, c := range b {
+		if c > 'Z'-'A' && r.err == nil {
+			r.err = fmt.Errorf("%w: letter %d", r.invalid, c)
+		}
+		b[i] = 'A' + c
+	}
+	return string(b)
+}
+
+// bits reads a bitfield of n ids
+func (r *bitReader) bits(n int) IDSet {
+	s := make(IDSet, n)
+	for i := range s {
+		s[i] = r.bool()
+	}
+	return s
+}
+
+// vendors reads the maximum vendor id followed by a bitfield or ranges of vendors
+func (r *bitReader) vendors() IDSet {
+	max := r.int(16)
+	if r.bool() {
+		return r.ranges(max)
+	}
+	return r.bits(max)
+}
+
+// ranges reads entries of single ids and ranges of them into a set of max ids, which they can't exceed
+func (r *bitReader) ranges(max int) IDSet {
+	ranges := r.idRanges()
+	if r.err != nil {
+		return nil
+	}
+	s := make(IDSet, max)
+	for _, e := range ranges {
+		if e.End > max {
+			r.err = fmt.Errorf("%w: vendor range %d-%d", r.invalid, e.Start, e.End)
+			return nil
+		}
+		for id := e.Start; id <= e.End; id++ {
+			s[id-1] = true
+		}
+	}
+	return s
+}
+
+// idRanges reads entries of single ids and ranges of them
+func (r *bitReader) idRanges() IDRanges {
+	var s IDRanges
+	for n := r.int(12); n > 0 && r.err == nil; n-- {
+		isRange := r.bool()
+		start := r.int(16)
+		end := start
+		if isRange {
+			end = r.int(16)
+		}
+		if r.err != nil {
+			break
+		}
+		if start < 1 || end < start {
+			r.err = fmt.Errorf("%w: vendor range %d-%d", r.invalid, start, end)
+			break
+		}
+		s = append(s, IDRange{Start: start, End: end})
+	}
+	return s
+}
@@ -0,0 +1,278 @@
+package twofive
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"reflect"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bitWriter encodes the segments of the TC strings of the tests
+type bitWriter struct {
+	b   []byte
+	pos int
+}
+
+func (w *bitWriter) int(v, n int) *bitWriter {
+	for i := n - 1; i >= 0; i-- {
+		if w.pos/8 == len(w.b) {
+			w.b = append(w.b, 0)
+		}
+		if v>>i&1 == 1 {
+			w.b[w.pos/8] |= 0x80 >> (w.pos % 8)
+		}
+		w.pos++
+	}
+	return w
+}
+
+func (w *bitWriter) bool(v bool) *bitWriter {
+	if v {
+		return w.int(1, 1)
+	}
+	return w.int(0, 1)
+}
+
+func (w *bitWriter) bits(n int, ids ...int) *bitWriter {
+	set := make([]bool, n)
+	for _, id := range ids {
+		set[id-1] = true
+	}
+	for _, v := range set {
+		w.bool(v)
+	}
+	return w
+}
+
+func (w *bitWriter) letters(s string) *bitWriter {
+	return w.int(int(s[0]-'A'), 6).int(int(s[1]-'A'), 6)
+}
+
+// ranges writes single ids as [id] and ranges as [start, end]
+func (w *bitWriter) ranges(entries ...[]int) *bitWriter {
+	w.int(len(entries), 12)
+	for _, e := range entries {
+		w.bool(len(e) == 2)
+		for _, id := range e {
+			w.int(id, 16)
+		}
+	}
+	return w
+}
+
+func (w *bitWriter) String() string {
+	return base64.RawURLEncoding.EncodeToString(w.b)
+}
+
+// coreSegment returns the core segment of a TC string with the purposes and vendors given consent, up to the
+// vendors
+func coreSegment(purposes []int, vendorConsents ...int) *bitWriter {
+	created := int(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC).Unix() * 10)
+	w := new(bitWriter).int(2, 6).int(created, 36).int(created+5, 36).int(7, 12).int(3, 12).int(1, 6).letters("EN")
+	w.int(48, 12).int(2, 6).bool(false).bool(true).bits(12, 1).bits(24, purposes...).bits(24, 2, 7)
+	w.bool(false).letters("DE")
+	w.int(12, 16).bool(false).bits(12, vendorConsents...)
+	return w
+}
+
+func TestParseTCString(t *testing.T) {
+	core := coreSegment([]int{1, 2, 3}, 1, 5, 12)
+	core.int(400, 16).bool(true).ranges([]int{8}, []int{100, 102}) // legitimate interests
+	core.int(2, 12)                                                // restrictions
+	core.int(2, 6).int(int(RestrictionNotAllowed), 2).ranges([]int{5})
+	core.int(3, 6).int(int(RestrictionRequireInterest), 2).ranges([]int{12, 13})
+
+	disclosed := new(bitWriter).int(segmentDisclosedVendors, 3).int(6, 16).bool(false).bits(6, 1, 6)
+	publisher := new(bitWriter).int(segmentPublisherTC, 3).bits(24, 1).bits(24, 2).int(2, 6).bits(2, 2).bits(2)
+	unknown := new(bitWriter).int(7, 3).int(1, 16)
+
+	got, err := ParseTCString(strings.Join([]string{core.String(), disclosed.String(), unknown.String(), publisher.String()}, "."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	want := &TCString{
+		Version:                   2,
+		Created:                   created,
+		LastUpdated:               created.Add(500 * time.Millisecond),
+		CMPID:                     7,
+		CMPVersion:                3,
+		ConsentScreen:             1,
+		ConsentLanguage:           "EN",
+		VendorListVersion:         48,
+		PolicyVersion:             2,
+		UseNonStandardTexts:       true,
+		SpecialFeatureOptIns:      idSet(12, 1),
+		PurposesConsent:           idSet(24, 1, 2, 3),
+		PurposesLITransparency:    idSet(24, 2, 7),
+		PublisherCC:               "DE",
+		VendorConsents:            idSet(12, 1, 5, 12),
+		VendorLegitimateInterests: idSet(400, 8, 100, 101, 102),
+		PublisherRestrictions: []PublisherRestriction{
+			{PurposeID: 2, Type: RestrictionNotAllowed, Vendors: IDRanges{{Start: 5, End: 5}}},
+			{PurposeID: 3, Type: RestrictionRequireInterest, Vendors: IDRanges{{Start: 12, End: 13}}},
+		},
+		DisclosedVendors: idSet(6, 1, 6),
+		PublisherTC: &PublisherTC{
+			PurposesConsent:              idSet(24, 1),
+			PurposesLITransparency:       idSet(24, 2),
+			CustomPurposesConsent:        idSet(2, 2),
+			CustomPurposesLITransparency: idSet(2),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+	if ids := got.VendorLegitimateInterests.IDs(); !reflect.DeepEqual(ids, []int{8, 100, 101, 102}) {
+		t.Errorf("IDs() = %v", ids)
+	}
+
+	tests := []struct {
+		vendor   int
+		purposes []int
+		want     bool
+	}{
+		{vendor: 1, want: true},
+		{vendor: 1, purposes: []int{1, 2, 3}, want: true},
+		{vendor: 1, purposes: []int{4}},
+		{vendor: 2, purposes: []int{1}},
+		{vendor: 8, purposes: []int{1}},
+		{vendor: 5, purposes: []int{1, 3}, want: true},
+		{vendor: 5, purposes: []int{2}},
+		{vendor: 12, purposes: []int{3}},
+		{vendor: 13},
+		{vendor: 0},
+	}
+	for _, tt := range tests {
+		if ok := got.HasVendorConsent(tt.vendor, tt.purposes...); ok != tt.want {
+			t.Errorf("HasVendorConsent(%d, %v) = %v, want %v", tt.vendor, tt.purposes, ok, tt.want)
+		}
+	}
+}
+
+func idSet(n int, ids ...int) IDSet {
+	s := make(IDSet, n)
+	for _, id := range ids {
+		s[id-1] = true
+	}
+	return s
+}
+
+func TestParseTCStringErrors(t *testing.T) {
+	valid := func() *bitWriter { return coreSegment(nil, 1).int(0, 16).bool(false).int(0, 12) }
+	truncated := valid()
+	truncated.b = truncated.b[:10]
+
+	tests := []struct {
+		name string
+		s    string
+	}{
+		{name: "empty", s: ""},
+		{name: "not base64", s: "CO!"},
+		{name: "version 1", s: new(bitWriter).int(1, 6).int(0, 200).String()},
+		{name: "truncated", s: truncated.String()},
+		{name: "bad letter", s: new(bitWriter).int(2, 6).int(0, 108).int(40, 6).int(0, 200).String()},
+		{name: "range past max", s: coreSegment(nil).int(3, 16).bool(true).ranges([]int{2, 4}).String()},
+		{name: "range backwards", s: coreSegment(nil).int(9, 16).bool(true).ranges([]int{4, 2}).String()},
+		{name: "bad segment", s: valid().String() + ".!"},
+		{name: "truncated segment", s: valid().String() + "." + new(bitWriter).int(segmentPublisherTC, 3).String()},
+	}
+
+	if _, err := ParseTCString(valid().String()); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseTCString(tt.s); !errors.Is(err, ErrInvalidTCString) {
+				t.Errorf("ParseTCString(%q) error = %v, want ErrInvalidTCString", tt.s, err)
+			}
+		})
+	}
+}
+
+// TestParseTCStringRestrictions parses the most restrictions a TC string can have, each of every vendor, which
+// must not be expanded to a set of every vendor id each
+func TestParseTCStringRestrictions(t *testing.T) {
+	core := coreSegment([]int{1}, 1).int(0, 16).bool(false).int(4095, 12)
+	for i := 0; i < 4095; i++ {
+		core.int(1, 6).int(int(RestrictionNotAllowed), 2).ranges([]int{1, 65535})
+	}
+	s := core.String()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	tc, err := ParseTCString(s)
+	runtime.ReadMemStats(&after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tc.PublisherRestrictions) != 4095 || !tc.PublisherRestrictions[4094].Vendors.Has(65535) {
+		t.Fatalf("restrictions = %d", len(tc.PublisherRestrictions))
+	}
+	// expanded, the restrictions take 4095 sets of 65535 ids, hundreds of megabytes
+	if n := after.TotalAlloc - before.TotalAlloc; n > 1<<20 {
+		t.Errorf("parsing a %d byte string allocated %d bytes", len(s), n)
+	}
+}
+
+func TestRequestHasVendorConsent(t *testing.T) {
+	consent := coreSegment([]int{1, 2}, 4).int(0, 16).bool(false).int(0, 12).String()
+
+	tests := []struct {
+		name    string
+		gdpr    *RegsExt
+		consent *UserExt
+		gpp     string
+		vendor  int
+		want    bool
+	}{
+		{name: "no gdpr ext", vendor: 3, want: true},
+		{name: "not gdpr", gdpr: &RegsExt{GDPR: 0}, vendor: 3, want: true},
+		{name: "consent", gdpr: &RegsExt{GDPR: 1}, consent: &UserExt{Consent: consent}, vendor: 4, want: true},
+		{name: "no consent", gdpr: &RegsExt{GDPR: 1}, consent: &UserExt{Consent: consent}, vendor: 3},
+		{name: "no user ext", gdpr: &RegsExt{GDPR: 1}, vendor: 4},
+		{name: "invalid", gdpr: &RegsExt{GDPR: 1}, consent: &UserExt{Consent: "BOEFEAyOEFEAyAHABDENAI4AAAB9vABAASA"}, vendor: 4},
+		{name: "GPP consent", gpp: gppHeader(GPPSectionTCFEUv2) + "~" + consent, vendor: 4, want: true},
+		{name: "GPP no consent", gpp: gppHeader(GPPSectionTCFEUv2) + "~" + consent, vendor: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &Request{Regs: Regs{GPP: tt.gpp, Ext: tt.gdpr}, User: User{Ext: tt.consent}}
+			if got := req.HasVendorConsent(tt.vendor, 1, 2); got != tt.want {
+				t.Errorf("HasVendorConsent() = %v, want %v", got, tt.want)
+			}
+
+			// the partner of the vendor is skipped by an exchange when there's no consent
+			var called bool
+			e := Exchange{Partners: []Partner{{
+				Name:     "vendor",
+				Bidder:   BidderFunc(func(context.Context, *Request) (*BidResponse, error) { called = true; return nil, nil }),
+				Adapters: []Adapter{RequireVendorConsent(tt.vendor, 1, 2)},
+			}}}
+			res, err := e.Run(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if called != tt.want || (res.Stats[0].Err == ErrNoVendorConsent) == tt.want {
+				t.Errorf("bidder called = %v with %v, want %v", called, res.Stats[0].Err, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzParseTCString(f *testing.F) {
+	f.Add(coreSegment([]int{1}, 2).int(0, 16).bool(true).ranges([]int{1, 3}).int(0, 12).String())
+	f.Add("CPXxRfAPXxRfAAfKABENB-CgAAAAAAAAAAYgAAAAAAAA.YAAAAAAAAAAA")
+	f.Fuzz(func(t *testing.T, s string) {
+		tc, err := ParseTCString(s)
+		if err == nil {
+			tc.HasVendorConsent(1, 1, 2)
+		}
+	})
+}
@@ -0,0 +1,393 @@
+// Package twosix models the OpenRTB 2.6 bid request, as sent to the partners that speak 2.6. The objects follow
+// the spec's names and types, the enumerations are left as plain ints and the extensions as raw JSON. The
+// twofive package converts its requests to and from them with ToV26 and FromV26
+package twosix
+
+import "encoding/json"
+
+// Header required in RTB requests
+const (
+	Header  = "x-openrtb-version"
+	Version = "2.6"
+)
+
+// BidRequest is the top level object of an OpenRTB 2.6 bid request
+type BidRequest struct {
+	ID      string          `json:"id"`
+	Imp     []Imp           `json:"imp"`
+	Site    *Site           `json:"site,omitempty"`
+	App     *App            `json:"app,omitempty"`
+	Device  *Device         `json:"device,omitempty"`
+	User    *User           `json:"user,omitempty"`
+	Test    int             `json:"test,omitempty"`
+	At      int             `json:"at,omitempty"`
+	TMax    int             `json:"tmax,omitempty"`
+	WSeat   []string        `json:"wseat,omitempty"`
+	BSeat   []string        `json:"bseat,omitempty"`
+	AllImps int             `json:"allimps,omitempty"`
+	Cur     []string        `json:"cur,omitempty"`
+	WLang   []string        `json:"wlang,omitempty"`
+	WLangB  []string        `json:"wlangb,omitempty"`
+	BCat    []string        `json:"bcat,omitempty"`
+	CatTax  int             `json:"cattax,omitempty"`
+	BAdv    []string        `json:"badv,omitempty"`
+	BApp    []string        `json:"bapp,omitempty"`
+	Source  *Source         `json:"source,omitempty"`
+	Regs    *Regs           `json:"regs,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// Source describes the entity upstream of the exchange, and the supply chain of the request since 2.6
+type Source struct {
+	FD     int             `json:"fd,omitempty"`
+	TID    string          `json:"tid,omitempty"`
+	PChain string          `json:"pchain,omitempty"`
+	SChain *SupplyChain    `json:"schain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// SupplyChain is the chain of the entities the request passed through, each of them a node
+type SupplyChain struct {
+	Complete int               `json:"complete"`
+	Nodes    []SupplyChainNode `json:"nodes"`
+	Ver      string            `json:"ver"`
+	Ext      json.RawMessage   `json:"ext,omitempty"`
+}
+
+// SupplyChainNode is an entity of a supply chain, identified by its advertising system and seller id
+type SupplyChainNode struct {
+	ASI    string          `json:"asi"`
+	SID    string          `json:"sid"`
+	RID    string          `json:"rid,omitempty"`
+	Name   string          `json:"name,omitempty"`
+	Domain string          `json:"domain,omitempty"`
+	HP     int             `json:"hp"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Regs are the regulations that apply to the request, which 2.6 carries in place of their 2.5 extensions
+type Regs struct {
+	COPPA     int             `json:"coppa,omitempty"`
+	GDPR      *int            `json:"gdpr,omitempty"`
+	USPrivacy string          `json:"us_privacy,omitempty"`
+	GPP       string          `json:"gpp,omitempty"`
+	GPPSID    []int           `json:"gpp_sid,omitempty"`
+	Ext       json.RawMessage `json:"ext,omitempty"`
+}
+
+// Imp describes an ad placement or impression being auctioned
+type Imp struct {
+	ID                string          `json:"id"`
+	Metric            []Metric        `json:"metric,omitempty"`
+	Banner            *Banner         `json:"banner,omitempty"`
+	Video             *Video          `json:"video,omitempty"`
+	Audio             *Audio          `json:"audio,omitempty"`
+	Native            *Native         `json:"native,omitempty"`
+	PMP               *PMP            `json:"pmp,omitempty"`
+	DisplayManager    string          `json:"displaymanager,omitempty"`
+	DisplayManagerVer string          `json:"displaymanagerver,omitempty"`
+	Instl             int             `json:"instl,omitempty"`
+	TagID             string          `json:"tagid,omitempty"`
+	BidFloor          float64         `json:"bidfloor,omitempty"`
+	BidFloorCur       string          `json:"bidfloorcur,omitempty"`
+	Secure            int             `json:"secure,omitempty"`
+	Exp               int             `json:"exp,omitempty"`
+	Rwdd              int             `json:"rwdd,omitempty"`
+	SSAI              int             `json:"ssai,omitempty"`
+	Ext               json.RawMessage `json:"ext,omitempty"`
+}
+
+// Metric is a metric of an impression, such as its recent viewability
+type Metric struct {
+	Type   string          `json:"type"`
+	Value  float64         `json:"value"`
+	Vendor string          `json:"vendor,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Banner is a display impression, or a companion ad of a video or audio impression
+type Banner struct {
+	Format []Format        `json:"format,omitempty"`
+	W      int             `json:"w,omitempty"`
+	H      int             `json:"h,omitempty"`
+	BType  []int           `json:"btype,omitempty"`
+	BAttr  []int           `json:"battr,omitempty"`
+	Pos    int             `json:"pos,omitempty"`
+	Mimes  []string        `json:"mimes,omitempty"`
+	API    []int           `json:"api,omitempty"`
+	ID     string          `json:"id,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Video is a video impression. Plcmt is the 2.6 placement subtype, Placement being deprecated
+type Video struct {
+	Mimes          []string        `json:"mimes"`
+	MinDuration    int             `json:"minduration,omitempty"`
+	MaxDuration    int             `json:"maxduration,omitempty"`
+	StartDelay     int             `json:"startdelay,omitempty"`
+	PodDur         int             `json:"poddur,omitempty"`
+	PodID          string          `json:"podid,omitempty"`
+	PodSeq         int             `json:"podseq,omitempty"`
+	Protocols      []int           `json:"protocols,omitempty"`
+	W              int             `json:"w,omitempty"`
+	H              int             `json:"h,omitempty"`
+	Plcmt          int             `json:"plcmt,omitempty"`
+	Placement      int             `json:"placement,omitempty"`
+	Linearity      int             `json:"linearity,omitempty"`
+	Skip           int             `json:"skip,omitempty"`
+	PlaybackMethod []int           `json:"playbackmethod,omitempty"`
+	Delivery       []int           `json:"delivery,omitempty"`
+	Pos            int             `json:"pos,omitempty"`
+	API            []int           `json:"api,omitempty"`
+	MinBitRate     int             `json:"minbitrate,omitempty"`
+	MaxBitRate     int             `json:"maxbitrate,omitempty"`
+	BoxingAllowed  int             `json:"boxingallowed,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Audio is an audio impression
+type Audio struct {
+	Mimes         []string        `json:"mimes"`
+	MinDuration   int             `json:"minduration,omitempty"`
+	MaxDuration   int             `json:"maxduration,omitempty"`
+	PodDur        int             `json:"poddur,omitempty"`
+	Protocols     []int           `json:"protocols,omitempty"`
+	StartDelay    int             `json:"startdelay,omitempty"`
+	PodID         string          `json:"podid,omitempty"`
+	PodSeq        int             `json:"podseq,omitempty"`
+	Sequence      int             `json:"sequence,omitempty"`
+	BAttr         []int           `json:"battr,omitempty"`
+	MaxExtended   int             `json:"maxextended,omitempty"`
+	MinBitRate    int             `json:"minbitrate,omitempty"`
+	MaxBitRate    int             `json:"maxbitrate,omitempty"`
+	Delivery      []int           `json:"delivery,omitempty"`
+	CompanionAd   []Banner        `json:"companionad,omitempty"`
+	API           []int           `json:"api,omitempty"`
+	CompanionType []int           `json:"companiontype,omitempty"`
+	MaxSeq        int             `json:"maxseq,omitempty"`
+	Feed          int             `json:"feed,omitempty"`
+	Stitched      int             `json:"stitched,omitempty"`
+	NVol          int             `json:"nvol,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// Native is a native impression, Request being the native ad request encoded as a string
+type Native struct {
+	Request string          `json:"request"`
+	Ver     string          `json:"ver,omitempty"`
+	API     []int           `json:"api,omitempty"`
+	BAttr   []int           `json:"battr,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// Format is a size allowed for a banner impression, absolute or flexible
+type Format struct {
+	W      int             `json:"w,omitempty"`
+	H      int             `json:"h,omitempty"`
+	WRatio int             `json:"wratio,omitempty"`
+	HRatio int             `json:"hratio,omitempty"`
+	WMin   int             `json:"wmin,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// PMP is the private marketplace of an impression, its deals
+type PMP struct {
+	PrivateAuction int             `json:"private_auction,omitempty"`
+	Deals          []Deal          `json:"deals,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Deal is a deal struck between a buyer and a seller for an impression
+type Deal struct {
+	ID          string          `json:"id"`
+	BidFloor    float64         `json:"bidfloor,omitempty"`
+	BidFloorCur string          `json:"bidfloorcur,omitempty"`
+	At          int             `json:"at,omitempty"`
+	WSeat       []string        `json:"wseat,omitempty"`
+	WADomain    []string        `json:"wadomain,omitempty"`
+	Ext         json.RawMessage `json:"ext,omitempty"`
+}
+
+// App is the non-browser application the ad is shown in
+type App struct {
+	ID            string          `json:"id,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Bundle        string          `json:"bundle,omitempty"`
+	Domain        string          `json:"domain,omitempty"`
+	StoreURL      string          `json:"storeurl,omitempty"`
+	CatTax        int             `json:"cattax,omitempty"`
+	Cat           []string        `json:"cat,omitempty"`
+	SectionCat    []string        `json:"sectioncat,omitempty"`
+	PageCat       []string        `json:"pagecat,omitempty"`
+	Ver           string          `json:"ver,omitempty"`
+	PrivacyPolicy int             `json:"privacypolicy,omitempty"`
+	Paid          int             `json:"paid,omitempty"`
+	Publisher     *Publisher      `json:"publisher,omitempty"`
+	Content       *Content        `json:"content,omitempty"`
+	Keywords      string          `json:"keywords,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// Site is the website the ad is shown in
+type Site struct {
+	ID            string          `json:"id,omitempty"`
+	Name          string          `json:"name,omitempty"`
+	Domain        string          `json:"domain,omitempty"`
+	CatTax        int             `json:"cattax,omitempty"`
+	Cat           []string        `json:"cat,omitempty"`
+	SectionCat    []string        `json:"sectioncat,omitempty"`
+	PageCat       []string        `json:"pagecat,omitempty"`
+	Page          string          `json:"page,omitempty"`
+	Ref           string          `json:"ref,omitempty"`
+	Search        string          `json:"search,omitempty"`
+	Mobile        int             `json:"mobile,omitempty"`
+	PrivacyPolicy int             `json:"privacypolicy,omitempty"`
+	Publisher     *Publisher      `json:"publisher,omitempty"`
+	Content       *Content        `json:"content,omitempty"`
+	Keywords      string          `json:"keywords,omitempty"`
+	Ext           json.RawMessage `json:"ext,omitempty"`
+}
+
+// Publisher is the publisher of the media the ad is shown in
+type Publisher struct {
+	ID     string          `json:"id,omitempty"`
+	Name   string          `json:"name,omitempty"`
+	CatTax int             `json:"cattax,omitempty"`
+	Cat    []string        `json:"cat,omitempty"`
+	Domain string          `json:"domain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Content is the content the impression appears in
+type Content struct {
+	ID                 string          `json:"id,omitempty"`
+	Episode            int             `json:"episode,omitempty"`
+	Title              string          `json:"title,omitempty"`
+	Series             string          `json:"series,omitempty"`
+	Season             string          `json:"season,omitempty"`
+	Artist             string          `json:"artist,omitempty"`
+	Genre              string          `json:"genre,omitempty"`
+	Album              string          `json:"album,omitempty"`
+	ISRC               string          `json:"isrc,omitempty"`
+	Producer           *Producer       `json:"producer,omitempty"`
+	URL                string          `json:"url,omitempty"`
+	CatTax             int             `json:"cattax,omitempty"`
+	Cat                []string        `json:"cat,omitempty"`
+	ProdQ              int             `json:"prodq,omitempty"`
+	Context            int             `json:"context,omitempty"`
+	ContentRating      string          `json:"contentrating,omitempty"`
+	UserRating         string          `json:"userrating,omitempty"`
+	QAGMediaRating     int             `json:"qagmediarating,omitempty"`
+	Keywords           string          `json:"keywords,omitempty"`
+	LiveStream         int             `json:"livestream,omitempty"`
+	SourceRelationship int             `json:"sourcerelationship,omitempty"`
+	Len                int             `json:"len,omitempty"`
+	Language           string          `json:"language,omitempty"`
+	LangB              string          `json:"langb,omitempty"`
+	Embeddable         int             `json:"embeddable,omitempty"`
+	Data               []Data          `json:"data,omitempty"`
+	Ext                json.RawMessage `json:"ext,omitempty"`
+}
+
+// Producer is the producer of the content the ad is shown in
+type Producer struct {
+	ID     string          `json:"id,omitempty"`
+	Name   string          `json:"name,omitempty"`
+	CatTax int             `json:"cattax,omitempty"`
+	Cat    []string        `json:"cat,omitempty"`
+	Domain string          `json:"domain,omitempty"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// Device is the device the user is interacting through
+type Device struct {
+	UA             string          `json:"ua,omitempty"`
+	Geo            *Geo            `json:"geo,omitempty"`
+	DNT            int             `json:"dnt,omitempty"`
+	Lmt            int             `json:"lmt,omitempty"`
+	IP             string          `json:"ip,omitempty"`
+	IPv6           string          `json:"ipv6,omitempty"`
+	DeviceType     int             `json:"devicetype,omitempty"`
+	Make           string          `json:"make,omitempty"`
+	Model          string          `json:"model,omitempty"`
+	OS             string          `json:"os,omitempty"`
+	OSV            string          `json:"osv,omitempty"`
+	HWV            string          `json:"hwv,omitempty"`
+	H              int             `json:"h,omitempty"`
+	W              int             `json:"w,omitempty"`
+	PPI            int             `json:"ppi,omitempty"`
+	PXRatio        float64         `json:"pxratio,omitempty"`
+	JS             int             `json:"js,omitempty"`
+	GeoFetch       int             `json:"geofetch,omitempty"`
+	FlashVer       string          `json:"flashver,omitempty"`
+	Language       string          `json:"language,omitempty"`
+	LangB          string          `json:"langb,omitempty"`
+	Carrier        string          `json:"carrier,omitempty"`
+	MCCMNC         string          `json:"mccmnc,omitempty"`
+	ConnectionType int             `json:"connectiontype,omitempty"`
+	IFA            string          `json:"ifa,omitempty"`
+	Ext            json.RawMessage `json:"ext,omitempty"`
+}
+
+// Geo is a location, of the device or of the user's home base
+type Geo struct {
+	Lat       float64         `json:"lat,omitempty"`
+	Lon       float64         `json:"lon,omitempty"`
+	Type      int             `json:"type,omitempty"`
+	Accuracy  int             `json:"accuracy,omitempty"`
+	LastFix   int             `json:"lastfix,omitempty"`
+	IPService int             `json:"ipservice,omitempty"`
+	Country   string          `json:"country,omitempty"`
+	Region    string          `json:"region,omitempty"`
+	Metro     string          `json:"metro,omitempty"`
+	City      string          `json:"city,omitempty"`
+	ZIP       string          `json:"zip,omitempty"`
+	UTCOffset int             `json:"utcoffset,omitempty"`
+	Ext       json.RawMessage `json:"ext,omitempty"`
+}
+
+// User is the human user of the device. Consent and EIDs are the TC string and extended ids 2.5 carries in
+// the ext
+type User struct {
+	ID         string          `json:"id,omitempty"`
+	BuyerUID   string          `json:"buyeruid,omitempty"`
+	YOB        int             `json:"yob,omitempty"`
+	Gender     string          `json:"gender,omitempty"`
+	Keywords   string          `json:"keywords,omitempty"`
+	CustomData string          `json:"customdata,omitempty"`
+	Geo        *Geo            `json:"geo,omitempty"`
+	Data       []Data          `json:"data,omitempty"`
+	Consent    string          `json:"consent,omitempty"`
+	EIDs       []EID           `json:"eids,omitempty"`
+	Ext        json.RawMessage `json:"ext,omitempty"`
+}
+
+// EID is the ids of the user given by a source
+type EID struct {
+	Source string          `json:"source"`
+	UIDs   []UID           `json:"uids"`
+	Ext    json.RawMessage `json:"ext,omitempty"`
+}
+
+// UID is an id of the user, AType the type of agent it identifies
+type UID struct {
+	ID    string          `json:"id"`
+	AType int             `json:"atype,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
+
+// Data is the data of a provider about the user, its segments
+type Data struct {
+	ID      string          `json:"id,omitempty"`
+	Name    string          `json:"name,omitempty"`
+	Segment []Segment       `json:"segment,omitempty"`
+	Ext     json.RawMessage `json:"ext,omitempty"`
+}
+
+// Segment is a key value pair of data about the user
+type Segment struct {
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Value string          `json:"value,omitempty"`
+	Ext   json.RawMessage `json:"ext,omitempty"`
+}
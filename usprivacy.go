@@ -0,0 +1,78 @@
+package twofive
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidUSPrivacy is wrapped by the errors of ParseUSPrivacy
+var ErrInvalidUSPrivacy = errors.New("twofive: invalid US Privacy string")
+
+// PrivacySignal is a signal of a US Privacy string, yes, no or not applicable
+type PrivacySignal byte
+
+// The signals of a US Privacy string
+const (
+	SignalYes           PrivacySignal = 'Y'
+	SignalNo            PrivacySignal = 'N'
+	SignalNotApplicable PrivacySignal = '-'
+)
+
+func (s PrivacySignal) String() string {
+	return string(s)
+}
+
+// IsValid reports if the signal is one of Y, N or -
+func (s PrivacySignal) IsValid() bool {
+	return s == SignalYes || s == SignalNo || s == SignalNotApplicable
+}
+
+// USPrivacy is an IAB US Privacy string of the CCPA, as passed in RegsExt.USPrivacy, e.g. 1YNN
+type USPrivacy struct {
+	Version int
+	Notice  PrivacySignal // explicit notice of the opportunity to opt out of the sale was given
+	OptOut  PrivacySignal // the user opted out of the sale of their personal data
+	LSPA    PrivacySignal // the transaction is covered by the IAB Limited Service Provider Agreement
+}
+
+// ParseUSPrivacy decodes a US Privacy string of version 1. The signals are read regardless of their case. The
+// error wraps ErrInvalidUSPrivacy
+func ParseUSPrivacy(s string) (USPrivacy, error) {
+	if len(s) != 4 {
+		return USPrivacy{}, fmt.Errorf("%w: length %d", ErrInvalidUSPrivacy, len(s))
+	}
+	if s[0] != '1' {
+		return USPrivacy{}, fmt.Errorf("%w: version %q", ErrInvalidUSPrivacy, s[0])
+	}
+	p := USPrivacy{Version: 1}
+	for i, signal := range []*PrivacySignal{&p.Notice, &p.OptOut, &p.LSPA} {
+		c := s[i+1]
+		if c >= 'a' && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if *signal = PrivacySignal(c); !signal.IsValid() {
+			return USPrivacy{}, fmt.Errorf("%w: signal %q", ErrInvalidUSPrivacy, s[i+1])
+		}
+	}
+	return p, nil
+}
+
+func (p USPrivacy) String() string {
+	return fmt.Sprintf("%d%c%c%c", p.Version, p.Notice, p.OptOut, p.LSPA)
+}
+
+// SaleOptedOut reports if the user opted out of the sale of their personal data
+func (p USPrivacy) SaleOptedOut() bool {
+	return p.OptOut == SignalYes
+}
+
+// SaleOptedOut reports if the user opted out of the sale of their personal data by the US Privacy string of
+// regs.ext.us_privacy, in which case the personal data of the request shouldn't be passed on. A missing or
+// invalid string isn't an opt out
+func (r *Request) SaleOptedOut() bool {
+	if r.Regs.Ext == nil || r.Regs.Ext.USPrivacy == "" {
+		return false
+	}
+	p, err := ParseUSPrivacy(r.Regs.Ext.USPrivacy)
+	return err == nil && p.SaleOptedOut()
+}
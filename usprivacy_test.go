@@ -0,0 +1,67 @@
+package twofive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseUSPrivacy(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    USPrivacy
+		err     bool
+		optOut  bool
+		encoded string
+	}{
+		{s: "1YNN", want: USPrivacy{Version: 1, Notice: SignalYes, OptOut: SignalNo, LSPA: SignalNo}, encoded: "1YNN"},
+		{s: "1YYY", want: USPrivacy{Version: 1, Notice: SignalYes, OptOut: SignalYes, LSPA: SignalYes}, optOut: true, encoded: "1YYY"},
+		{s: "1---", want: USPrivacy{Version: 1, Notice: SignalNotApplicable, OptOut: SignalNotApplicable, LSPA: SignalNotApplicable}, encoded: "1---"},
+		{s: "1nyn", want: USPrivacy{Version: 1, Notice: SignalNo, OptOut: SignalYes, LSPA: SignalNo}, optOut: true, encoded: "1NYN"},
+		{s: "", err: true},
+		{s: "1YN", err: true},
+		{s: "1YNNY", err: true},
+		{s: "2YNN", err: true},
+		{s: "1YXN", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParseUSPrivacy(tt.s)
+			if tt.err {
+				if !errors.Is(err, ErrInvalidUSPrivacy) {
+					t.Errorf("ParseUSPrivacy() error = %v, want ErrInvalidUSPrivacy", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want || got.SaleOptedOut() != tt.optOut || got.String() != tt.encoded {
+				t.Errorf("ParseUSPrivacy() = %+v (%s), want %+v", got, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestSaleOptedOut(t *testing.T) {
+	tests := []struct {
+		name string
+		regs *RegsExt
+		want bool
+	}{
+		{name: "no regs ext"},
+		{name: "no string", regs: &RegsExt{GDPR: 1}},
+		{name: "opted out", regs: &RegsExt{USPrivacy: "1YYN"}, want: true},
+		{name: "not opted out", regs: &RegsExt{USPrivacy: "1YNN"}},
+		{name: "invalid", regs: &RegsExt{USPrivacy: "YYYY"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &Request{Regs: Regs{Ext: tt.regs}}
+			if got := req.SaleOptedOut(); got != tt.want {
+				t.Errorf("SaleOptedOut() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
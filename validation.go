@@ -0,0 +1,340 @@
+package twofive
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ValidationError describes a single rule a value failed. Path is the JSON path of the offending field, e.g.
+// imp[0].video.protocols[2], Rule is the rule as written in the valid struct tag, e.g. inintarr(2|3|5|6)
+type ValidationError struct {
+	Path  string      `json:"path"`
+	Rule  string      `json:"rule"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: failed %s, got %v", e.Path, e.Rule, e.Value)
+}
+
+// ValidationErrors is every rule a value failed, in the order the fields are declared
+type ValidationErrors []ValidationError
+
+func (v ValidationErrors) Error() string {
+	s := make([]string, len(v))
+	for i, e := range v {
+		s[i] = e.Error()
+	}
+	return strings.Join(s, "; ")
+}
+
+// Validate checks the request against its valid struct tags, without the need of an external validator, and
+// then the DefaultRules. A nil error means the request is valid, otherwise the error is always of type
+// ValidationErrors. Every required tag is enforced, that of ext.session_id included, so a request without a
+// session id doesn't validate
+func (r *Request) Validate() error {
+	return r.ValidateWith(DefaultRules...)
+}
+
+// ValidateWith checks the request against its valid struct tags and then the given rules in place of the
+// DefaultRules, a nil error means the request is valid, otherwise the error is always of type ValidationErrors
+func (r *Request) ValidateWith(rules ...Rule) error {
+	errs := validateStruct(reflect.ValueOf(r).Elem(), "", nil)
+	for _, rule := range rules {
+		errs = append(errs, rule.Check(r)...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validator reports if a single, non empty, value passes the rule
+type validator func(v reflect.Value, args []string) bool
+
+var validators = map[string]validator{
+	"range":           validRange,
+	"in":              validIn,
+	"inintarr":        validIn,
+	"ipv4":            validIPv4,
+	"ipv6":            validIPv6,
+	"uuidv4":          validUUIDv4,
+	"ISO3166Alpha3":   validISO3166Alpha3,
+	"base64rawstring": validBase64RawString,
+	"matches":         validMatches,
+}
+
+// validateStruct walks the fields of v applying their valid tags, the same way the govalidator tags are
+// interpreted: "-" skips the field, empty values only fail "required" and structs are always descended into
+func validateStruct(v reflect.Value, path string, errs ValidationErrors) ValidationErrors {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		tag := f.Tag.Get("valid")
+		if tag == "-" || tag == "_" {
+			continue
+		}
+
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if path != "" {
+			name = path + "." + name
+		}
+		errs = validateValue(v.Field(i), name, parseRules(tag), errs)
+	}
+	return errs
+}
+
+func validateValue(v reflect.Value, path string, rules []rule, errs ValidationErrors) ValidationErrors {
+	if isEmpty(v) {
+		for _, r := range rules {
+			if r.name == "required" {
+				return append(errs, ValidationError{Path: path, Rule: r.String(), Value: valueOf(v)})
+			}
+		}
+		return errs
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return validateValue(v.Elem(), path, rules, errs)
+	case reflect.Struct:
+		return validateStruct(v, path, errs)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			errs = validateElem(v.Index(i), path+"["+strconv.Itoa(i)+"]", rules, errs)
+		}
+		return errs
+	}
+	return validateElem(v, path, rules, errs)
+}
+
+// validateElem applies the rules to a single value, for slices the rules are applied to each element
+func validateElem(v reflect.Value, path string, rules []rule, errs ValidationErrors) ValidationErrors {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return errs
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		return validateStruct(v, path, errs)
+	}
+
+	for _, r := range rules {
+		if r.name == "required" || r.name == "optional" {
+			continue
+		}
+		fn, ok := validators[r.name]
+		if !ok || !fn(v, r.args) {
+			errs = append(errs, ValidationError{Path: path, Rule: r.String(), Value: valueOf(v)})
+		}
+	}
+	return errs
+}
+
+type rule struct {
+	name string
+	args []string
+}
+
+func (r rule) String() string {
+	if r.args == nil {
+		return r.name
+	}
+	return r.name + "(" + strings.Join(r.args, "|") + ")"
+}
+
+// parseRules splits a valid tag such as "range(0|1),optional" into its rules
+func parseRules(tag string) []rule {
+	var rules []rule
+	for _, s := range splitTag(tag) {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		r := rule{name: s}
+		if i := strings.IndexByte(s, '('); i > 0 && strings.HasSuffix(s, ")") {
+			r.name = s[:i]
+			r.args = strings.Split(s[i+1:len(s)-1], "|")
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// splitTag splits on the commas that aren't inside of parenthesis
+func splitTag(tag string) []string {
+	var parts []string
+	var depth, start int
+	for i := 0; i < len(tag); i++ {
+		switch tag[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, tag[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, tag[start:])
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	return v.IsZero()
+}
+
+func valueOf(v reflect.Value) interface{} {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil
+	}
+	return v.Interface()
+}
+
+func toFloat(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	case reflect.String:
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func validRange(v reflect.Value, args []string) bool {
+	if len(args) != 2 {
+		return false
+	}
+	f, ok := toFloat(v)
+	if !ok {
+		return false
+	}
+	min, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return false
+	}
+	max, err := strconv.ParseFloat(args[1], 64)
+	if err != nil {
+		return false
+	}
+	return f >= min && f <= max
+}
+
+func validIn(v reflect.Value, args []string) bool {
+	s := v.String()
+	if v.Kind() != reflect.String {
+		f, ok := toFloat(v)
+		if !ok {
+			return false
+		}
+		s = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	for _, a := range args {
+		if s == a {
+			return true
+		}
+	}
+	return false
+}
+
+func validIPv4(v reflect.Value, _ []string) bool {
+	return v.Kind() == reflect.String && net.ParseIP(v.String()) != nil && strings.Contains(v.String(), ".")
+}
+
+func validIPv6(v reflect.Value, _ []string) bool {
+	return v.Kind() == reflect.String && net.ParseIP(v.String()) != nil && strings.Contains(v.String(), ":")
+}
+
+var uuidv4 = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+
+func validUUIDv4(v reflect.Value, _ []string) bool {
+	return v.Kind() == reflect.String && uuidv4.MatchString(v.String())
+}
+
+func validBase64RawString(v reflect.Value, _ []string) bool {
+	if v.Kind() != reflect.String {
+		return false
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(v.String()); err == nil {
+		return true
+	}
+	_, err := base64.RawStdEncoding.DecodeString(v.String())
+	return err == nil
+}
+
+// patterns caches the regular expressions of matches rules
+var patterns sync.Map
+
+// validMatches matches a string against the regular expression of the rule, as the alternations of the expression
+// were split as the arguments of the rule they're joined back
+func validMatches(v reflect.Value, args []string) bool {
+	if v.Kind() != reflect.String {
+		return false
+	}
+	expr := strings.Join(args, "|")
+	re, ok := patterns.Load(expr)
+	if !ok {
+		compiled, err := regexp.Compile(expr)
+		if err != nil {
+			return false
+		}
+		re, _ = patterns.LoadOrStore(expr, compiled)
+	}
+	return re.(*regexp.Regexp).MatchString(v.String())
+}
+
+func validISO3166Alpha3(v reflect.Value, _ []string) bool {
+	if v.Kind() != reflect.String {
+		return false
+	}
+	_, ok := iso3166Alpha3[v.String()]
+	return ok
+}
+
+var iso3166Alpha3 = map[string]struct{}{}
+
+func init() {
+	const codes = "ABW AFG AGO AIA ALA ALB AND ARE ARG ARM ASM ATA ATF ATG AUS AUT AZE BDI BEL BEN BES BFA BGD BGR " +
+		"BHR BHS BIH BLM BLR BLZ BMU BOL BRA BRB BRN BTN BVT BWA CAF CAN CCK CHE CHL CHN CIV CMR COD COG COK COL " +
+		"COM CPV CRI CUB CUW CXR CYM CYP CZE DEU DJI DMA DNK DOM DZA ECU EGY ERI ESH ESP EST ETH FIN FJI FLK FRA " +
+		"FRO FSM GAB GBR GEO GGY GHA GIB GIN GLP GMB GNB GNQ GRC GRD GRL GTM GUF GUM GUY HKG HMD HND HRV HTI HUN " +
+		"IDN IMN IND IOT IRL IRN IRQ ISL ISR ITA JAM JEY JOR JPN KAZ KEN KGZ KHM KIR KNA KOR KWT LAO LBN LBR LBY " +
+		"LCA LIE LKA LSO LTU LUX LVA MAC MAF MAR MCO MDA MDG MDV MEX MHL MKD MLI MLT MMR MNE MNG MNP MOZ MRT MSR " +
+		"MTQ MUS MWI MYS MYT NAM NCL NER NFK NGA NIC NIU NLD NOR NPL NRU NZL OMN PAK PAN PCN PER PHL PLW PNG POL " +
+		"PRI PRK PRT PRY PSE PYF QAT REU ROU RUS RWA SAU SDN SEN SGP SGS SHN SJM SLB SLE SLV SMR SOM SPM SRB SSD " +
+		"STP SUR SVK SVN SWE SWZ SXM SYC SYR TCA TCD TGO THA TJK TKL TKM TLS TON TTO TUN TUR TUV TWN TZA UGA UKR " +
+		"UMI URY USA UZB VAT VCT VEN VGB VIR VNM VUT WLF WSM YEM ZAF ZMB ZWE"
+	for _, c := range strings.Fields(codes) {
+		iso3166Alpha3[c] = struct{}{}
+	}
+}
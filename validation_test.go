@@ -0,0 +1,181 @@
+package twofive
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func loadRequest(t testing.TB, file string) *Request {
+	t.Helper()
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r Request
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	return &r
+}
+
+// validRequestJSON reads a fixture, adding the session id Validate requires to those that predate it
+func validRequestJSON(t testing.TB, file string) []byte {
+	t.Helper()
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte(`"session_id"`)) {
+		return b
+	}
+	return bytes.Replace(b, []byte(`"api_key":`), []byte(`"session_id": "4c8a2e5b-1b8e-4f0e-9a3c-6f0f1e8d2b7a", "api_key":`), 1)
+}
+
+// loadValidRequest decodes a fixture read by validRequestJSON
+func loadValidRequest(t testing.TB, file string) *Request {
+	t.Helper()
+	var r Request
+	if err := json.Unmarshal(validRequestJSON(t, file), &r); err != nil {
+		t.Fatal(err)
+	}
+	return &r
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		mutate func(r *Request)
+		want   ValidationErrors
+	}{
+		{
+			name: "Static Bid Request",
+			file: "./test_data/static_bid_request.json",
+		},
+		{
+			name: "Video Bid Request",
+			file: "./test_data/video_bid_request.json",
+		},
+		{
+			name: "Site Bid Request",
+			file: "./test_data/site_bid_request.json",
+		},
+		{
+			name: "Native Bid Request",
+			file: "./test_data/native_bid_request.json",
+		},
+		{
+			name:   "Missing Session ID",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Ext.SessionID = "" },
+			want:   ValidationErrors{{Path: "ext.session_id", Rule: "required", Value: ""}},
+		},
+		{
+			name:   "Missing API Key",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Ext.APIKey = "" },
+			want:   ValidationErrors{{Path: "ext.api_key", Rule: "required", Value: ""}},
+		},
+		{
+			name:   "Bad API Key",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Ext.APIKey = "foo" },
+			want:   ValidationErrors{{Path: "ext.api_key", Rule: "uuidv4", Value: "foo"}},
+		},
+		{
+			name:   "Bad Protocol",
+			file:   "./test_data/video_bid_request.json",
+			mutate: func(r *Request) { r.Imp[0].Video.Protocols[2] = 9 },
+			want:   ValidationErrors{{Path: "imp[0].video.protocols[2]", Rule: "inintarr(2|3|5|6)", Value: Protocol(9)}},
+		},
+		{
+			name:   "Bad Banner Position",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Imp[0].Banner.Pos = 8 },
+			want:   ValidationErrors{{Path: "imp[0].banner.pos", Rule: "range(0|7)", Value: AdPosition(8)}},
+		},
+		{
+			name: "Missing App Fields",
+			file: "./test_data/static_bid_request.json",
+			mutate: func(r *Request) {
+				r.App.Name = ""
+				r.App.Publisher.Domain = ""
+			},
+			want: ValidationErrors{
+				{Path: "app.name", Rule: "required", Value: ""},
+				{Path: "app.publisher.domain", Rule: "required", Value: ""},
+			},
+		},
+		{
+			name: "Bad Device",
+			file: "./test_data/static_bid_request.json",
+			mutate: func(r *Request) {
+				r.Device.IP = "::1"
+				r.Device.Make = "Nokia"
+				r.Device.Geo.Country = "US"
+			},
+			want: ValidationErrors{
+				{Path: "device.geo.country", Rule: "ISO3166Alpha3", Value: "US"},
+				{Path: "device.ip", Rule: "ipv4", Value: "::1"},
+				{Path: "device.make", Rule: "in(Apple|Android|apple|android)", Value: "Nokia"},
+			},
+		},
+		{
+			name:   "Bad Gender",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.User.Gender = "foo" },
+			want:   ValidationErrors{{Path: "user.gender", Rule: "in(M|F|O|Male|male|Female|female)", Value: "foo"}},
+		},
+		{
+			name:   "Bad GDPR",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Regs.Ext.GDPR = 3 },
+			want:   ValidationErrors{{Path: "regs.ext.gdpr", Rule: "range(0|1)", Value: 3}},
+		},
+		{
+			name:   "Good US Privacy",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Regs.Ext.USPrivacy = "1yN-" },
+		},
+		{
+			name:   "Bad US Privacy",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.Regs.Ext.USPrivacy = "1YNNN" },
+			want:   ValidationErrors{{Path: "regs.ext.us_privacy", Rule: "matches(^1[YNyn-]{3}$)", Value: "1YNNN"}},
+		},
+		{
+			name:   "No App Or Site",
+			file:   "./test_data/static_bid_request.json",
+			mutate: func(r *Request) { r.App = nil },
+			want:   ValidationErrors{{Path: "app", Rule: "oneof(app|site)"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := loadValidRequest(t, tt.file)
+			if tt.mutate != nil {
+				tt.mutate(r)
+			}
+
+			err := r.Validate()
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+
+			got, ok := err.(ValidationErrors)
+			if !ok {
+				t.Fatalf("Validate() = %T %v, want ValidationErrors", err, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}